@@ -88,6 +88,25 @@ var (
 			number:    7,
 			migration: migrateOptionalChannelCloseSummaryFields,
 		},
+		{
+			// The DB version that added an index of payment hashes
+			// by their current payment status.
+			number:    8,
+			migration: migratePaymentStatusIndex,
+		},
+		{
+			// The DB version that added an index of outgoing
+			// payments by their payment hash.
+			number:    9,
+			migration: migratePaymentHashIndex,
+		},
+		{
+			// The DB version that added a serialization version
+			// to outgoing payments, along with the failure reason,
+			// fail date, and individual HTLC attempts.
+			number:    10,
+			migration: migrateOutgoingPaymentAttempts,
+		},
 	}
 
 	// Big endian is the preferred byte order, due to cursor scans over
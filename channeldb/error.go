@@ -39,6 +39,15 @@ var (
 	// created.
 	ErrNoPaymentsCreated = fmt.Errorf("there are no existing payments")
 
+	// ErrPaymentNotFound is returned when the payments bucket exists, but
+	// no payment with the target payment hash can be found.
+	ErrPaymentNotFound = fmt.Errorf("payment with payment hash not found")
+
+	// ErrPaymentHashMismatch is returned when an outgoing payment would be
+	// written under a payment hash which doesn't match its preimage.
+	ErrPaymentHashMismatch = fmt.Errorf("payment preimage doesn't match " +
+		"payment hash")
+
 	// ErrNodeNotFound is returned when node bucket exists, but node with
 	// specific identity can't be found.
 	ErrNodeNotFound = fmt.Errorf("link node with target identity not found")
@@ -376,6 +376,98 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 	}
 }
 
+// TestSettleInvoices tests that a batch of invoices can be settled at once,
+// and that a batch containing an unknown invoice is rejected in its
+// entirety.
+func TestSettleInvoices(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// We'll start out by writing a series of invoices to the DB.
+	const numInvoices = 3
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	invoices := make([]*Invoice, numInvoices)
+	settlements := make([]InvoiceSettlement, numInvoices)
+	for i := 0; i < numInvoices; i++ {
+		invoice, err := randInvoice(amt)
+		if err != nil {
+			t.Fatalf("unable to create invoice: %v", err)
+		}
+
+		if _, err := db.AddInvoice(invoice); err != nil {
+			t.Fatalf("unable to add invoice %v", err)
+		}
+
+		invoices[i] = invoice
+		settlements[i] = InvoiceSettlement{
+			PaymentHash: sha256.Sum256(
+				invoice.Terms.PaymentPreimage[:],
+			),
+			AmtPaid: amt + lnwire.MilliSatoshi(i),
+		}
+	}
+
+	// A batch that includes an unknown invoice should fail, leaving the
+	// known invoices unsettled.
+	var unknownHash [32]byte
+	_, err = db.SettleInvoices([]InvoiceSettlement{
+		settlements[0],
+		{PaymentHash: unknownHash, AmtPaid: amt},
+	})
+	if err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got: %v", err)
+	}
+
+	dbInvoice, err := db.LookupInvoice(settlements[0].PaymentHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+	if dbInvoice.Terms.Settled {
+		t.Fatalf("invoice shouldn't be settled after failed batch")
+	}
+
+	// We'll now settle the entire batch. Each invoice should be returned
+	// in order, with an increasing settle index.
+	dbInvoices, err := db.SettleInvoices(settlements)
+	if err != nil {
+		t.Fatalf("unable to settle invoices: %v", err)
+	}
+	if len(dbInvoices) != numInvoices {
+		t.Fatalf("expected %v invoices, got %v", numInvoices,
+			len(dbInvoices))
+	}
+
+	for i, dbInvoice := range dbInvoices {
+		invoice := invoices[i]
+		invoice.SettleIndex = uint64(i + 1)
+		invoice.Terms.Settled = true
+		invoice.AmtPaid = settlements[i].AmtPaid
+		invoice.SettleDate = dbInvoice.SettleDate
+
+		if !reflect.DeepEqual(dbInvoice, invoice) {
+			t.Fatalf("wrong invoice after settle, expected %v "+
+				"got %v", spew.Sdump(invoice),
+				spew.Sdump(dbInvoice))
+		}
+	}
+
+	// All of the settled invoices should be reflected within the settle
+	// index.
+	settled, err := db.InvoicesSettledSince(1)
+	if err != nil {
+		t.Fatalf("unable to query settled invoices: %v", err)
+	}
+	if len(settled) != numInvoices-1 {
+		t.Fatalf("expected %v settled invoices, got %v",
+			numInvoices-1, len(settled))
+	}
+}
+
 // TestQueryInvoices ensures that we can properly query the invoice database for
 // invoices using different types of queries.
 func TestQueryInvoices(t *testing.T) {
@@ -645,3 +737,114 @@ func TestQueryInvoices(t *testing.T) {
 		}
 	}
 }
+
+// TestLookupInvoiceTerms tests that the contract terms returned by
+// LookupInvoiceTerms match those of the full invoice, both before and after
+// the invoice has been settled.
+func TestLookupInvoiceTerms(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Looking up the terms of an invoice before any invoices have been
+	// created should fail.
+	var fakeHash [32]byte
+	if _, err := db.LookupInvoiceTerms(fakeHash); err != ErrNoInvoicesCreated {
+		t.Fatalf("expected ErrNoInvoicesCreated, got %v", err)
+	}
+
+	invoice, err := randInvoice(lnwire.NewMSatFromSatoshis(10000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+
+	paymentHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+	terms, err := db.LookupInvoiceTerms(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice terms: %v", err)
+	}
+	if !reflect.DeepEqual(invoice.Terms, terms) {
+		t.Fatalf("terms don't match: expected %v, got %v",
+			spew.Sdump(invoice.Terms), spew.Sdump(terms))
+	}
+
+	// Once settled, the terms should reflect the new settlement status.
+	if _, err := db.SettleInvoice(paymentHash, invoice.Terms.Value); err != nil {
+		t.Fatalf("unable to settle invoice: %v", err)
+	}
+	terms, err = db.LookupInvoiceTerms(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice terms: %v", err)
+	}
+	if !terms.Settled {
+		t.Fatalf("invoice terms should be settled")
+	}
+
+	// Finally, an unknown payment hash should result in a "not found"
+	// error.
+	if _, err := db.LookupInvoiceTerms(fakeHash); err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+}
+
+// benchmarkInvoiceLookup populates a database with a set of invoices carrying
+// max size payment requests, then measures the passed lookup method.
+func benchmarkInvoiceLookup(b *testing.B,
+	lookup func(*DB, [32]byte) error) {
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		b.Fatalf("unable to make test db: %v", err)
+	}
+
+	const numInvoices = 100
+	hashes := make([][32]byte, 0, numInvoices)
+	for i := 0; i < numInvoices; i++ {
+		invoice, err := randInvoice(lnwire.MilliSatoshi(i + 1))
+		if err != nil {
+			b.Fatalf("unable to create invoice: %v", err)
+		}
+		invoice.Memo = make([]byte, MaxMemoSize)
+		invoice.Receipt = make([]byte, MaxReceiptSize)
+		invoice.PaymentRequest = make([]byte, MaxPaymentRequestSize)
+
+		if _, err := db.AddInvoice(invoice); err != nil {
+			b.Fatalf("unable to add invoice: %v", err)
+		}
+
+		hashes = append(
+			hashes, sha256.Sum256(invoice.Terms.PaymentPreimage[:]),
+		)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := lookup(db, hashes[i%numInvoices]); err != nil {
+			b.Fatalf("unable to lookup invoice: %v", err)
+		}
+	}
+}
+
+func BenchmarkLookupInvoice(b *testing.B) {
+	benchmarkInvoiceLookup(b, func(db *DB, hash [32]byte) error {
+		_, err := db.LookupInvoice(hash)
+		return err
+	})
+}
+
+func BenchmarkLookupInvoiceTerms(b *testing.B) {
+	benchmarkInvoiceLookup(b, func(db *DB, hash [32]byte) error {
+		_, err := db.LookupInvoiceTerms(hash)
+		return err
+	})
+}
@@ -354,6 +354,51 @@ func (d *DB) LookupInvoice(paymentHash [32]byte) (Invoice, error) {
 	return invoice, nil
 }
 
+// LookupInvoiceTerms is a lightweight variant of LookupInvoice which only
+// returns the contract terms of the invoice paying to the target payment hash.
+// The variable length fields of the invoice (memo, receipt and payment
+// request) are skipped over rather than copied, making this method suitable
+// for hot paths which only need to know the preimage, value and settlement
+// status of an invoice.
+func (d *DB) LookupInvoiceTerms(paymentHash [32]byte) (ContractTerm, error) {
+	var terms ContractTerm
+	err := d.View(func(tx *bolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		// Check the invoice index to see if an invoice paying to this
+		// hash exists within the DB.
+		invoiceNum := invoiceIndex.Get(paymentHash[:])
+		if invoiceNum == nil {
+			return ErrInvoiceNotFound
+		}
+
+		invoiceBytes := invoices.Get(invoiceNum)
+		if invoiceBytes == nil {
+			return ErrInvoiceNotFound
+		}
+
+		t, err := deserializeInvoiceTerms(bytes.NewReader(invoiceBytes))
+		if err != nil {
+			return err
+		}
+		terms = t
+
+		return nil
+	})
+	if err != nil {
+		return terms, err
+	}
+
+	return terms, nil
+}
+
 // FetchAllInvoices returns all invoices currently stored within the database.
 // If the pendingOnly param is true, then only unsettled invoices will be
 // returned, skipping all invoices that are fully settled.
@@ -572,8 +617,41 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 func (d *DB) SettleInvoice(paymentHash [32]byte,
 	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
 
-	var settledInvoice *Invoice
+	settledInvoices, err := d.SettleInvoices([]InvoiceSettlement{
+		{
+			PaymentHash: paymentHash,
+			AmtPaid:     amtPaid,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return settledInvoices[0], nil
+}
+
+// InvoiceSettlement describes the settlement of a single invoice within a
+// batch passed to SettleInvoices.
+type InvoiceSettlement struct {
+	// PaymentHash is the payment hash of the invoice to be settled.
+	PaymentHash [32]byte
+
+	// AmtPaid is the amount that was paid towards the invoice.
+	AmtPaid lnwire.MilliSatoshi
+}
+
+// SettleInvoices attempts to mark a batch of invoices as fully settled within
+// a single database transaction. The settled invoices are returned in the same
+// order as the passed settlements. If any of the invoices doesn't exist within
+// the database, then the entire batch will fail with a "not found" error, and
+// none of the invoices will be settled.
+func (d *DB) SettleInvoices(settlements []InvoiceSettlement) ([]*Invoice,
+	error) {
+
+	var settledInvoices []*Invoice
 	err := d.Update(func(tx *bolt.Tx) error {
+		settledInvoices = make([]*Invoice, 0, len(settlements))
+
 		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
 		if err != nil {
 			return err
@@ -591,28 +669,32 @@ func (d *DB) SettleInvoice(paymentHash [32]byte,
 			return err
 		}
 
-		// Check the invoice index to see if an invoice paying to this
-		// hash exists within the DB.
-		invoiceNum := invoiceIndex.Get(paymentHash[:])
-		if invoiceNum == nil {
-			return ErrInvoiceNotFound
-		}
+		for _, settlement := range settlements {
+			// Check the invoice index to see if an invoice paying
+			// to this hash exists within the DB.
+			invoiceNum := invoiceIndex.Get(settlement.PaymentHash[:])
+			if invoiceNum == nil {
+				return ErrInvoiceNotFound
+			}
 
-		invoice, err := settleInvoice(
-			invoices, settleIndex, invoiceNum, amtPaid,
-		)
-		if err != nil {
-			return err
+			invoice, err := settleInvoice(
+				invoices, settleIndex, invoiceNum,
+				settlement.AmtPaid,
+			)
+			if err != nil {
+				return err
+			}
+
+			settledInvoices = append(settledInvoices, invoice)
 		}
 
-		settledInvoice = invoice
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return settledInvoice, nil
+	return settledInvoices, nil
 }
 
 // InvoicesSettledSince can be used by callers to catch up any settled invoices
@@ -862,6 +944,46 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 	return invoice, nil
 }
 
+// deserializeInvoiceTerms reads only the ContractTerm of a serialized invoice.
+// The leading variable length fields are skipped by seeking past them, so no
+// allocations are made for the memo, receipt, payment request or dates.
+func deserializeInvoiceTerms(r *bytes.Reader) (ContractTerm, error) {
+	var terms ContractTerm
+
+	// The memo, receipt, payment request, creation date and settle date
+	// are all written as var bytes, so we'll skip over each of them in
+	// turn.
+	for i := 0; i < 5; i++ {
+		fieldLen, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return terms, err
+		}
+		if fieldLen > uint64(r.Len()) {
+			return terms, io.ErrUnexpectedEOF
+		}
+
+		_, err = r.Seek(int64(fieldLen), io.SeekCurrent)
+		if err != nil {
+			return terms, err
+		}
+	}
+
+	if _, err := io.ReadFull(r, terms.PaymentPreimage[:]); err != nil {
+		return terms, err
+	}
+	var scratch [8]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return terms, err
+	}
+	terms.Value = lnwire.MilliSatoshi(byteOrder.Uint64(scratch[:]))
+
+	if err := binary.Read(r, byteOrder, &terms.Settled); err != nil {
+		return terms, err
+	}
+
+	return terms, nil
+}
+
 func settleInvoice(invoices, settleIndex *bolt.Bucket, invoiceNum []byte,
 	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
 
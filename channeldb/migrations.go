@@ -610,3 +610,131 @@ func migrateOptionalChannelCloseSummaryFields(tx *bolt.Tx) error {
 
 	return nil
 }
+
+// migratePaymentStatusIndex is a database migration that populates the new
+// payment status index from the existing set of payment statuses, allowing
+// payments to be efficiently looked up by their status.
+func migratePaymentStatusIndex(tx *bolt.Tx) error {
+	paymentStatuses := tx.Bucket(paymentStatusBucket)
+	if paymentStatuses == nil {
+		return nil
+	}
+
+	log.Infof("Populating payment status index")
+
+	err := paymentStatuses.ForEach(func(paymentHash, status []byte) error {
+		var ps PaymentStatus
+		if err := ps.FromBytes(status); err != nil {
+			return err
+		}
+
+		var hash [32]byte
+		copy(hash[:], paymentHash)
+
+		return putPaymentStatusIndex(tx, hash, ps)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to populate payment status "+
+			"index: %v", err)
+	}
+
+	log.Infof("Migration to payment status index complete!")
+
+	return nil
+}
+
+// migratePaymentHashIndex is a database migration that populates the payment
+// hash index, which maps the payment hash of each existing outgoing payment to
+// the key it's stored under within the payments bucket.
+func migratePaymentHashIndex(tx *bolt.Tx) error {
+	payments := tx.Bucket(paymentBucket)
+	if payments == nil {
+		return nil
+	}
+
+	log.Infof("Populating payment hash index")
+
+	err := payments.ForEach(func(k, paymentBytes []byte) error {
+		// If the value is nil, then we ignore it as it may be a
+		// sub-bucket.
+		if paymentBytes == nil {
+			return nil
+		}
+
+		payment, err := deserializeOutgoingPayment(
+			bytes.NewReader(paymentBytes),
+		)
+		if err != nil {
+			return err
+		}
+
+		paymentID := make([]byte, len(k))
+		copy(paymentID, k)
+
+		paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
+		return putPaymentHashIndex(tx, paymentHash, paymentID)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to populate payment hash index: %v",
+			err)
+	}
+
+	log.Infof("Migration to payment hash index complete!")
+
+	return nil
+}
+
+// migrateOutgoingPaymentAttempts is a database migration that rewrites all
+// outgoing payments in the versioned serialization format, which includes the
+// failure reason, fail date, and HTLC attempts of each payment. Existing
+// payments are written with these fields left empty.
+func migrateOutgoingPaymentAttempts(tx *bolt.Tx) error {
+	payBucket := tx.Bucket(paymentBucket)
+	if payBucket == nil {
+		return nil
+	}
+
+	log.Infof("Migrating outgoing payments to versioned format")
+
+	// As a bucket can't be modified while iterating over it, we'll first
+	// re-serialize each payment, then write them back to disk.
+	migratedPayments := make(map[string][]byte)
+	err := payBucket.ForEach(func(payID, paymentBytes []byte) error {
+		// Ignore the value if it is a sub-bucket.
+		if paymentBytes == nil {
+			return nil
+		}
+
+		// The deserialization handles payments without a version, so
+		// we can read the legacy format directly.
+		payment, err := deserializeOutgoingPayment(
+			bytes.NewReader(paymentBytes),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to deserialize payment: %v",
+				err)
+		}
+
+		var b bytes.Buffer
+		if err := serializeOutgoingPayment(&b, payment); err != nil {
+			return err
+		}
+
+		migratedPayments[string(payID)] = b.Bytes()
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for payID, paymentBytes := range migratedPayments {
+		if err := payBucket.Put([]byte(payID), paymentBytes); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Migration to versioned outgoing payments complete!")
+
+	return nil
+}
@@ -468,3 +468,255 @@ func TestMigrateOptionalChannelCloseSummaryFields(t *testing.T) {
 			false)
 	}
 }
+
+// TestPaymentStatusIndexMigration checks that existing payment statuses are
+// added to the payment status index by the migration.
+func TestPaymentStatusIndexMigration(t *testing.T) {
+	t.Parallel()
+
+	inFlightHash := makeFakePaymentHash()
+	completedHash := makeFakePaymentHash()
+
+	// Write the payment statuses directly to the status bucket, as they
+	// would've been stored prior to the introduction of the index.
+	beforeMigrationFunc := func(d *DB) {
+		err := d.Update(func(tx *bolt.Tx) error {
+			err := tx.DeleteBucket(paymentStatusIndexBucket)
+			if err != nil && err != bolt.ErrBucketNotFound {
+				return err
+			}
+
+			paymentStatuses, err := tx.CreateBucketIfNotExists(
+				paymentStatusBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = paymentStatuses.Put(
+				inFlightHash[:], StatusInFlight.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+
+			return paymentStatuses.Put(
+				completedHash[:], StatusCompleted.Bytes(),
+			)
+		})
+		if err != nil {
+			t.Fatalf("unable to write payment statuses: %v", err)
+		}
+
+		inFlight, err := d.FetchPaymentsByStatus(StatusInFlight)
+		if err != nil {
+			t.Fatalf("unable to fetch payments by status: %v", err)
+		}
+		if len(inFlight) != 0 {
+			t.Fatalf("expected no indexed payments, got %v",
+				len(inFlight))
+		}
+	}
+
+	// After the migration, each payment should be found under its status.
+	afterMigrationFunc := func(d *DB) {
+		meta, err := d.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.DbVersionNumber != 1 {
+			t.Fatal("migration 'migratePaymentStatusIndex' wasn't " +
+				"applied")
+		}
+
+		inFlight, err := d.FetchPaymentsByStatus(StatusInFlight)
+		if err != nil {
+			t.Fatalf("unable to fetch payments by status: %v", err)
+		}
+		if !reflect.DeepEqual(inFlight, [][32]byte{inFlightHash}) {
+			t.Fatalf("wrong in flight payments: expected %x, "+
+				"got %x", inFlightHash, inFlight)
+		}
+
+		completed, err := d.FetchPaymentsByStatus(StatusCompleted)
+		if err != nil {
+			t.Fatalf("unable to fetch payments by status: %v", err)
+		}
+		if !reflect.DeepEqual(completed, [][32]byte{completedHash}) {
+			t.Fatalf("wrong completed payments: expected %x, "+
+				"got %x", completedHash, completed)
+		}
+	}
+
+	applyMigration(t,
+		beforeMigrationFunc,
+		afterMigrationFunc,
+		migratePaymentStatusIndex,
+		false)
+}
+
+// TestPaymentHashIndexMigration checks that existing payments are added to
+// the payment hash index by the migration.
+func TestPaymentHashIndexMigration(t *testing.T) {
+	t.Parallel()
+
+	const numPayments = 3
+	var paymentHashes [][32]byte
+
+	// Add a series of payments, then remove the hash index to mimic a
+	// database created prior to its introduction.
+	beforeMigrationFunc := func(d *DB) {
+		for i := 0; i < numPayments; i++ {
+			payment, err := makeRandomFakePayment()
+			if err != nil {
+				t.Fatalf("unable to create payment: %v", err)
+			}
+			if err := d.AddPayment(payment); err != nil {
+				t.Fatalf("unable to add payment: %v", err)
+			}
+
+			paymentHashes = append(
+				paymentHashes,
+				sha256.Sum256(payment.PaymentPreimage[:]),
+			)
+		}
+
+		err := d.Update(func(tx *bolt.Tx) error {
+			return tx.DeleteBucket(paymentHashIndexBucket)
+		})
+		if err != nil {
+			t.Fatalf("unable to delete payment hash index: %v", err)
+		}
+	}
+
+	// After the migration, each payment hash should map to the key of its
+	// payment, which are assigned sequentially from 1.
+	afterMigrationFunc := func(d *DB) {
+		meta, err := d.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.DbVersionNumber != 1 {
+			t.Fatal("migration 'migratePaymentHashIndex' wasn't " +
+				"applied")
+		}
+
+		err = d.View(func(tx *bolt.Tx) error {
+			hashIndex := tx.Bucket(paymentHashIndexBucket)
+			if hashIndex == nil {
+				return fmt.Errorf("payment hash index not found")
+			}
+
+			for i, paymentHash := range paymentHashes {
+				paymentID := make([]byte, 8)
+				byteOrder.PutUint64(paymentID, uint64(i+1))
+
+				indexedID := hashIndex.Get(paymentHash[:])
+				if !bytes.Equal(indexedID, paymentID) {
+					return fmt.Errorf("wrong payment id for "+
+						"payment %v: expected %x, got %x",
+						i, paymentID, indexedID)
+				}
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	applyMigration(t,
+		beforeMigrationFunc,
+		afterMigrationFunc,
+		migratePaymentHashIndex,
+		false)
+}
+
+// TestOutgoingPaymentAttemptsMigration checks that payments stored in the
+// legacy format are rewritten in the versioned format by the migration.
+func TestOutgoingPaymentAttemptsMigration(t *testing.T) {
+	t.Parallel()
+
+	fakePayment := makeFakePayment()
+	fakePayment.LastFailureCode = 0
+	fakePayment.LastError = ""
+	fakePayment.Attempts = nil
+
+	// Write the payment to disk in the legacy format, which ends directly
+	// after the payment preimage.
+	beforeMigrationFunc := func(d *DB) {
+		var b bytes.Buffer
+		if err := serializeOutgoingPayment(&b, fakePayment); err != nil {
+			t.Fatalf("unable to serialize payment: %v", err)
+		}
+		legacyBytes := b.Bytes()[:legacyPaymentLen(fakePayment)]
+
+		err := d.Update(func(tx *bolt.Tx) error {
+			payments, err := tx.CreateBucketIfNotExists(
+				paymentBucket,
+			)
+			if err != nil {
+				return err
+			}
+
+			paymentID := make([]byte, 8)
+			binary.BigEndian.PutUint64(paymentID, 1)
+
+			return payments.Put(paymentID, legacyBytes)
+		})
+		if err != nil {
+			t.Fatalf("unable to add legacy payment: %v", err)
+		}
+	}
+
+	// After the migration, the payment should be stored in the current
+	// format and otherwise be unchanged.
+	afterMigrationFunc := func(d *DB) {
+		meta, err := d.FetchMeta(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if meta.DbVersionNumber != 1 {
+			t.Fatal("migration 'migrateOutgoingPaymentAttempts' " +
+				"wasn't applied")
+		}
+
+		var expectedBytes bytes.Buffer
+		err = serializeOutgoingPayment(&expectedBytes, fakePayment)
+		if err != nil {
+			t.Fatalf("unable to serialize payment: %v", err)
+		}
+
+		var paymentBytes []byte
+		err = d.View(func(tx *bolt.Tx) error {
+			paymentID := make([]byte, 8)
+			binary.BigEndian.PutUint64(paymentID, 1)
+
+			paymentBytes = tx.Bucket(paymentBucket).Get(paymentID)
+			if paymentBytes == nil {
+				return fmt.Errorf("payment not found")
+			}
+
+			paymentBytes = append([]byte(nil), paymentBytes...)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to fetch payment: %v", err)
+		}
+
+		if !bytes.Equal(paymentBytes, expectedBytes.Bytes()) {
+			t.Fatalf("payment wasn't migrated: expected %x, got %x",
+				expectedBytes.Bytes(), paymentBytes)
+		}
+	}
+
+	applyMigration(t,
+		beforeMigrationFunc,
+		afterMigrationFunc,
+		migrateOutgoingPaymentAttempts,
+		false)
+}
@@ -2,10 +2,14 @@ package channeldb
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
+	"time"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -22,6 +26,38 @@ var (
 	// paymentStatusBucket is the name of the bucket within the database that
 	// stores the status of a payment indexed by the payment's preimage.
 	paymentStatusBucket = []byte("payment-status")
+
+	// paymentStatusIndexBucket is the name of the bucket within the
+	// database that indexes payment hashes by their current payment
+	// status. Within this bucket, there is a sub-bucket for each status,
+	// keyed by the status byte, that maps:
+	//
+	//   paymentHash => nil
+	//
+	// This allows us to efficiently enumerate all payments with a
+	// particular status, such as all payments that are still in flight.
+	paymentStatusIndexBucket = []byte("payment-status-index")
+
+	// paymentHashIndexBucket is the name of the bucket within the
+	// database that maps the payment hash of each outgoing payment to the
+	// key it's stored under within the payments bucket:
+	//
+	//   paymentHash => paymentID
+	//
+	// This allows a payment to be located by its hash without scanning
+	// the entire payments bucket.
+	paymentHashIndexBucket = []byte("payment-hash-index")
+
+	// paymentAttemptsBucket is the name of the bucket within the database
+	// that records the attempts made to pay each payment hash that
+	// doesn't yet have a successful payment recorded:
+	//
+	//   paymentHash => version || paymentAttemptInfo
+	//
+	// Once a payment succeeds, its attempts are moved onto the outgoing
+	// payment itself. Otherwise, they remain here so that the history of
+	// failed payments can be inspected.
+	paymentAttemptsBucket = []byte("payment-attempts")
 )
 
 // PaymentStatus represent current status of payment
@@ -77,6 +113,59 @@ func (ps PaymentStatus) String() string {
 	}
 }
 
+const (
+	// outgoingPaymentVersion is the current serialization version of
+	// outgoing payments. Payments written before the introduction of this
+	// version end directly after the payment preimage.
+	outgoingPaymentVersion byte = 1
+
+	// maxPaymentErrorSize is the maximum size of an error string stored
+	// alongside an outgoing payment or one of its attempts.
+	maxPaymentErrorSize = 1024
+)
+
+// PaymentAttempt records a single attempt to send an HTLC as part of an
+// outgoing payment.
+type PaymentAttempt struct {
+	// Timestamp is the time at which the attempt was made.
+	Timestamp time.Time
+
+	// Path encodes the path the attempt took through the network,
+	// excluding the outgoing node, as the compressed public key of each
+	// of the nodes involved.
+	Path [][33]byte
+
+	// FailureCode is the failure code returned for this attempt. If the
+	// attempt succeeded, this is zero.
+	FailureCode lnwire.FailCode
+
+	// Error is a human readable description of the reason the attempt
+	// failed, if any.
+	Error string
+}
+
+// PaymentAttemptInfo records the history of the attempts made to pay a
+// payment hash. The creation and settle times of a successful payment are
+// recorded within the CreationDate and SettleDate of its invoice.
+type PaymentAttemptInfo struct {
+	// FailDate is the time at which the payment was deemed to have
+	// failed. If the payment hasn't failed, then this is the zero time.
+	FailDate time.Time
+
+	// LastFailureCode is the failure code of the most recent failed
+	// attempt of this payment.
+	LastFailureCode lnwire.FailCode
+
+	// LastError is a human readable description of the most recent
+	// error encountered while attempting this payment.
+	LastError string
+
+	// Attempts is the set of HTLC attempts made for this payment, in the
+	// order they were made. The number of attempts made is the length of
+	// this slice.
+	Attempts []PaymentAttempt
+}
+
 // OutgoingPayment represents a successful payment between the daemon and a
 // remote node. Details such as the total fee paid, and the time of the payment
 // are stored.
@@ -98,10 +187,15 @@ type OutgoingPayment struct {
 	// PaymentPreimage is the preImage of a successful payment. This is used
 	// to calculate the PaymentHash as well as serve as a proof of payment.
 	PaymentPreimage [32]byte
+
+	// PaymentAttemptInfo records the HTLC attempts made for this payment,
+	// along with the details of the most recent failure.
+	PaymentAttemptInfo
 }
 
 // AddPayment saves a successful payment to the database. It is assumed that
-// all payment are sent using unique payment hashes.
+// all payment are sent using unique payment hashes. Any attempts recorded for
+// the payment hash are moved onto the payment as it's added.
 func (db *DB) AddPayment(payment *OutgoingPayment) error {
 	// Validate the field of the inner voice within the outgoing payment,
 	// these must also adhere to the same constraints as regular invoices.
@@ -109,14 +203,7 @@ func (db *DB) AddPayment(payment *OutgoingPayment) error {
 		return err
 	}
 
-	// We first serialize the payment before starting the database
-	// transaction so we can avoid creating a DB payment in the case of a
-	// serialization error.
-	var b bytes.Buffer
-	if err := serializeOutgoingPayment(&b, payment); err != nil {
-		return err
-	}
-	paymentBytes := b.Bytes()
+	paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
 
 	return db.Batch(func(tx *bolt.Tx) error {
 		payments, err := tx.CreateBucketIfNotExists(paymentBucket)
@@ -124,6 +211,18 @@ func (db *DB) AddPayment(payment *OutgoingPayment) error {
 			return err
 		}
 
+		// As the transaction may be retried, we'll move the recorded
+		// attempts onto a copy of the payment.
+		p := *payment
+		if err := movePaymentAttempts(tx, paymentHash, &p); err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeOutgoingPayment(&b, &p); err != nil {
+			return err
+		}
+
 		// Obtain the new unique sequence number for this payment.
 		paymentID, err := payments.NextSequence()
 		if err != nil {
@@ -136,10 +235,225 @@ func (db *DB) AddPayment(payment *OutgoingPayment) error {
 		paymentIDBytes := make([]byte, 8)
 		binary.BigEndian.PutUint64(paymentIDBytes, paymentID)
 
-		return payments.Put(paymentIDBytes, paymentBytes)
+		err = payments.Put(paymentIDBytes, b.Bytes())
+		if err != nil {
+			return err
+		}
+
+		return putPaymentHashIndex(tx, paymentHash, paymentIDBytes)
 	})
 }
 
+// AddPaymentAttempt records an attempt made to pay the target payment hash.
+// If the attempt failed, then its failure code and error also become the last
+// failure of the payment. As a new attempt means the payment is being retried,
+// any prior fail date of the payment is cleared. Attempts made for a payment
+// hash that already has a successful payment recorded are ignored.
+func (db *DB) AddPaymentAttempt(paymentHash [32]byte,
+	attempt *PaymentAttempt) error {
+
+	update := func(info *PaymentAttemptInfo) {
+		info.FailDate = time.Time{}
+		info.Attempts = append(info.Attempts, *attempt)
+
+		if attempt.FailureCode != 0 || attempt.Error != "" {
+			info.LastFailureCode = attempt.FailureCode
+			info.LastError = attempt.Error
+		}
+	}
+
+	return db.updatePaymentAttempts(paymentHash, update)
+}
+
+// FailPayment records that the payment to the target payment hash failed at
+// the passed time. If non-empty, the reason becomes the last error of the
+// payment, as the final failure may not be the result of a single attempt,
+// for example if no further routes could be found.
+func (db *DB) FailPayment(paymentHash [32]byte, failDate time.Time,
+	reason string) error {
+
+	update := func(info *PaymentAttemptInfo) {
+		info.FailDate = failDate
+		if reason != "" {
+			info.LastError = reason
+		}
+	}
+
+	return db.updatePaymentAttempts(paymentHash, update)
+}
+
+// updatePaymentAttempts applies the passed update closure to the attempts
+// recorded for the target payment hash, unless a successful payment to the
+// payment hash has already been recorded.
+func (db *DB) updatePaymentAttempts(paymentHash [32]byte,
+	update func(*PaymentAttemptInfo)) error {
+
+	return db.Batch(func(tx *bolt.Tx) error {
+		if payments := tx.Bucket(paymentBucket); payments != nil {
+			_, payment, err := fetchPaymentByHash(
+				tx, payments, paymentHash,
+			)
+			if err != nil {
+				return err
+			}
+			if payment != nil {
+				return nil
+			}
+		}
+
+		attempts, err := tx.CreateBucketIfNotExists(
+			paymentAttemptsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		info, err := fetchPaymentAttemptInfo(attempts, paymentHash)
+		if err != nil {
+			return err
+		}
+		if info == nil {
+			info = &PaymentAttemptInfo{}
+		}
+
+		update(info)
+
+		// The attempts are prefixed with the same serialization
+		// version as outgoing payments.
+		var b bytes.Buffer
+		b.WriteByte(outgoingPaymentVersion)
+		if err := serializePaymentAttemptInfo(&b, info); err != nil {
+			return err
+		}
+
+		return attempts.Put(paymentHash[:], b.Bytes())
+	})
+}
+
+// FetchPaymentAttempts returns the attempts made to pay the target payment
+// hash, along with the details of its last failure. This covers both payments
+// that have succeeded and those that haven't. If no attempts have been
+// recorded for the payment hash, then ErrPaymentNotFound is returned.
+func (db *DB) FetchPaymentAttempts(paymentHash [32]byte) (*PaymentAttemptInfo,
+	error) {
+
+	var info *PaymentAttemptInfo
+	err := db.View(func(tx *bolt.Tx) error {
+		if payments := tx.Bucket(paymentBucket); payments != nil {
+			_, payment, err := fetchPaymentByHash(
+				tx, payments, paymentHash,
+			)
+			if err != nil {
+				return err
+			}
+			if payment != nil {
+				info = &payment.PaymentAttemptInfo
+				return nil
+			}
+		}
+
+		attempts := tx.Bucket(paymentAttemptsBucket)
+		if attempts == nil {
+			return ErrPaymentNotFound
+		}
+
+		var err error
+		info, err = fetchPaymentAttemptInfo(attempts, paymentHash)
+		if err != nil {
+			return err
+		}
+		if info == nil {
+			return ErrPaymentNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// fetchPaymentAttemptInfo reads the attempts recorded for the target payment
+// hash from the payment attempts bucket. If none are found, then nil is
+// returned.
+func fetchPaymentAttemptInfo(attempts *bolt.Bucket,
+	paymentHash [32]byte) (*PaymentAttemptInfo, error) {
+
+	infoBytes := attempts.Get(paymentHash[:])
+	if infoBytes == nil {
+		return nil, nil
+	}
+
+	r := bytes.NewReader(infoBytes)
+
+	var version [1]byte
+	if _, err := io.ReadFull(r, version[:]); err != nil {
+		return nil, err
+	}
+	if version[0] != outgoingPaymentVersion {
+		return nil, fmt.Errorf("unknown payment attempts version: %v",
+			version[0])
+	}
+
+	info := &PaymentAttemptInfo{}
+	if err := deserializePaymentAttemptInfo(r, info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// movePaymentAttempts moves the attempts recorded for the target payment hash
+// onto the passed payment, which is about to be written as a successful
+// payment. The recorded attempts precede any already set on the payment, and
+// the creation date of the payment becomes that of its first attempt.
+func movePaymentAttempts(tx *bolt.Tx, paymentHash [32]byte,
+	payment *OutgoingPayment) error {
+
+	attempts := tx.Bucket(paymentAttemptsBucket)
+	if attempts == nil {
+		return nil
+	}
+
+	info, err := fetchPaymentAttemptInfo(attempts, paymentHash)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return nil
+	}
+
+	payment.Attempts = append(info.Attempts, payment.Attempts...)
+	if payment.LastError == "" && payment.LastFailureCode == 0 {
+		payment.LastFailureCode = info.LastFailureCode
+		payment.LastError = info.LastError
+	}
+
+	if len(payment.Attempts) > 0 {
+		firstAttempt := payment.Attempts[0].Timestamp
+		if firstAttempt.Before(payment.CreationDate) {
+			payment.CreationDate = firstAttempt
+		}
+	}
+
+	return attempts.Delete(paymentHash[:])
+}
+
+// putPaymentHashIndex maps the payment hash to the key of the payment within
+// the payments bucket.
+func putPaymentHashIndex(tx *bolt.Tx, paymentHash [32]byte,
+	paymentID []byte) error {
+
+	hashIndex, err := tx.CreateBucketIfNotExists(paymentHashIndexBucket)
+	if err != nil {
+		return err
+	}
+
+	return hashIndex.Put(paymentHash[:], paymentID)
+}
+
 // FetchAllPayments returns all outgoing payments in DB.
 func (db *DB) FetchAllPayments() ([]*OutgoingPayment, error) {
 	var payments []*OutgoingPayment
@@ -174,6 +488,211 @@ func (db *DB) FetchAllPayments() ([]*OutgoingPayment, error) {
 	return payments, nil
 }
 
+// PaymentsQuery represents a query to the payments database. The query allows
+// a caller to retrieve payments starting from a particular payment index,
+// limit the number of results returned, and filter the payments by their
+// creation date and status.
+type PaymentsQuery struct {
+	// IndexOffset is the offset within the payment indices to start at.
+	// This can be used to start the response at a particular payment.
+	IndexOffset uint64
+
+	// NumMaxPayments is the maximum number of payments that should be
+	// returned starting from the payment index.
+	NumMaxPayments uint64
+
+	// Reversed, if set, indicates that the payments returned should start
+	// from the IndexOffset and go backwards.
+	Reversed bool
+
+	// StartTime, if non-zero, excludes all payments created before this
+	// time.
+	StartTime time.Time
+
+	// EndTime, if non-zero, excludes all payments created after this
+	// time.
+	EndTime time.Time
+
+	// Statuses, if non-empty, restricts the response to payments which
+	// currently have one of the listed statuses.
+	Statuses []PaymentStatus
+}
+
+// matches returns true if the payment with the given status satisfies the
+// filters of the query.
+func (q *PaymentsQuery) matches(p *OutgoingPayment, status PaymentStatus) bool {
+	if !q.StartTime.IsZero() && p.CreationDate.Before(q.StartTime) {
+		return false
+	}
+	if !q.EndTime.IsZero() && p.CreationDate.After(q.EndTime) {
+		return false
+	}
+
+	if len(q.Statuses) == 0 {
+		return true
+	}
+	for _, s := range q.Statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PaymentsSlice is the response to a payments query. It includes the original
+// query, the set of payments that match the query, and the payment indexes of
+// the first and last payments returned.
+type PaymentsSlice struct {
+	PaymentsQuery
+
+	// Payments is the set of payments that matched the query above.
+	Payments []*OutgoingPayment
+
+	// FirstIndexOffset is the index of the first element in the set of
+	// returned Payments above. Callers can use this to resume their query
+	// in the event that the slice has too many events to fit into a single
+	// response.
+	FirstIndexOffset uint64
+
+	// LastIndexOffset is the index of the last element in the set of
+	// returned Payments above. Callers can use this to resume their query
+	// in the event that the slice has too many events to fit into a single
+	// response.
+	LastIndexOffset uint64
+}
+
+// QueryPayments allows a caller to query the payments database for payments
+// within the specified payment index range, without loading the entire set of
+// payments into memory.
+func (db *DB) QueryPayments(q PaymentsQuery) (PaymentsSlice, error) {
+	resp := PaymentsSlice{
+		PaymentsQuery: q,
+	}
+
+	// indexes tracks the payment index of each payment added to the
+	// response, in the order they were visited.
+	var indexes []uint64
+
+	err := db.View(func(tx *bolt.Tx) error {
+		// If the bucket wasn't found, then there aren't any payments
+		// within the database yet, so we can simply exit.
+		payments := tx.Bucket(paymentBucket)
+		if payments == nil {
+			return ErrNoPaymentsCreated
+		}
+
+		// nextPayment is a helper closure to determine what the next
+		// payment is when iterating over the payments bucket.
+		nextPayment := func(c *bolt.Cursor) ([]byte, []byte) {
+			if q.Reversed {
+				return c.Prev()
+			}
+			return c.Next()
+		}
+
+		// We'll be using a cursor to seek into the database and return
+		// a slice of payments. We'll need to determine where to start
+		// our cursor depending on the parameters set within the query.
+		var (
+			c                = payments.Cursor()
+			paymentIDBytes   [8]byte
+			paymentKey, data []byte
+		)
+		switch {
+		// When iterating forwards, we'll start at the first payment
+		// following the offset.
+		case !q.Reversed:
+			binary.BigEndian.PutUint64(
+				paymentIDBytes[:], q.IndexOffset+1,
+			)
+			paymentKey, data = c.Seek(paymentIDBytes[:])
+
+		// If no offset was specified in reverse, then we just start
+		// from the last payment.
+		case q.IndexOffset == 0:
+			paymentKey, data = c.Last()
+
+		// Otherwise we start iteration at the payment prior to the
+		// offset. If there are no payments at or after the offset,
+		// then the last payment is the one prior to it.
+		default:
+			binary.BigEndian.PutUint64(paymentIDBytes[:], q.IndexOffset)
+			paymentKey, _ = c.Seek(paymentIDBytes[:])
+			if paymentKey == nil {
+				paymentKey, data = c.Last()
+			} else {
+				paymentKey, data = c.Prev()
+			}
+		}
+
+		// We'll continue until either we reach the end of the range,
+		// or reach our max number of payments.
+		for ; paymentKey != nil; paymentKey, data = nextPayment(c) {
+			if uint64(len(resp.Payments)) >= q.NumMaxPayments {
+				break
+			}
+
+			// If the value is nil, then we ignore it as it may be
+			// a sub-bucket.
+			if data == nil {
+				continue
+			}
+
+			payment, err := deserializeOutgoingPayment(
+				bytes.NewReader(data),
+			)
+			if err != nil {
+				return err
+			}
+
+			status, err := FetchPaymentStatusTx(
+				tx, sha256.Sum256(payment.PaymentPreimage[:]),
+			)
+			if err != nil {
+				return err
+			}
+
+			if !q.matches(payment, status) {
+				continue
+			}
+
+			resp.Payments = append(resp.Payments, payment)
+			indexes = append(
+				indexes, binary.BigEndian.Uint64(paymentKey),
+			)
+		}
+
+		return nil
+	})
+	if err != nil && err != ErrNoPaymentsCreated {
+		return resp, err
+	}
+
+	// If we iterated through the payments in reverse order, then we'll
+	// need to reverse the slice of payments to return them in forward
+	// order.
+	if q.Reversed {
+		numPayments := len(resp.Payments)
+		for i := 0; i < numPayments/2; i++ {
+			opposite := numPayments - i - 1
+			resp.Payments[i], resp.Payments[opposite] =
+				resp.Payments[opposite], resp.Payments[i]
+			indexes[i], indexes[opposite] =
+				indexes[opposite], indexes[i]
+		}
+	}
+
+	// Finally, record the indexes of the first and last payments returned
+	// so that the caller can resume from this point later on.
+	if len(resp.Payments) > 0 {
+		resp.FirstIndexOffset = indexes[0]
+		resp.LastIndexOffset = indexes[len(indexes)-1]
+	}
+
+	return resp, nil
+}
+
 // DeleteAllPayments deletes all payments from DB.
 func (db *DB) DeleteAllPayments() error {
 	return db.Update(func(tx *bolt.Tx) error {
@@ -182,11 +701,286 @@ func (db *DB) DeleteAllPayments() error {
 			return err
 		}
 
+		err = tx.DeleteBucket(paymentHashIndexBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
+		err = tx.DeleteBucket(paymentAttemptsBucket)
+		if err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+
 		_, err = tx.CreateBucket(paymentBucket)
 		return err
 	})
 }
 
+// UpdatePayment atomically applies the passed update closure to the outgoing
+// payment paying to the target payment hash and to its payment status, within
+// a single database transaction. If the payment hasn't been recorded yet, then
+// the closure is passed an empty payment, which will only be added to the
+// database if the closure populates its preimage. In all cases, the preimage
+// of the resulting payment must match the payment hash. If the closure
+// returns an error, then no changes are made.
+//
+// This allows callers to, for example, record a successful payment and
+// transition it to StatusCompleted without the possibility of another
+// caller observing or interleaving with the intermediate state.
+func (db *DB) UpdatePayment(paymentHash [32]byte,
+	update func(*OutgoingPayment, *PaymentStatus) error) error {
+
+	return db.Update(func(tx *bolt.Tx) error {
+		payments, err := tx.CreateBucketIfNotExists(paymentBucket)
+		if err != nil {
+			return err
+		}
+
+		paymentKey, payment, err := fetchPaymentByHash(
+			tx, payments, paymentHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		// If we don't yet know of this payment, then we'll pass an
+		// empty payment to the closure.
+		isNew := payment == nil
+		if isNew {
+			payment = &OutgoingPayment{}
+		}
+
+		oldStatus, err := FetchPaymentStatusTx(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+		status := oldStatus
+
+		if err := update(payment, &status); err != nil {
+			return err
+		}
+
+		if status != oldStatus {
+			err := UpdatePaymentStatusTx(tx, paymentHash, status)
+			if err != nil {
+				return err
+			}
+		}
+
+		// A new payment that is still lacking its preimage isn't
+		// written, as we only record completed payments.
+		if isNew && payment.PaymentPreimage == [32]byte{} {
+			return nil
+		}
+
+		if sha256.Sum256(payment.PaymentPreimage[:]) != paymentHash {
+			return ErrPaymentHashMismatch
+		}
+		if err := validateInvoice(&payment.Invoice); err != nil {
+			return err
+		}
+
+		// A new payment takes on any attempts recorded for its
+		// payment hash.
+		if isNew {
+			err := movePaymentAttempts(tx, paymentHash, payment)
+			if err != nil {
+				return err
+			}
+		}
+
+		var b bytes.Buffer
+		if err := serializeOutgoingPayment(&b, payment); err != nil {
+			return err
+		}
+
+		// If this is a new payment, then we'll obtain a new unique
+		// sequence number for it.
+		if isNew {
+			paymentID, err := payments.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			paymentKey = make([]byte, 8)
+			binary.BigEndian.PutUint64(paymentKey, paymentID)
+
+			err = putPaymentHashIndex(tx, paymentHash, paymentKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		return payments.Put(paymentKey, b.Bytes())
+	})
+}
+
+// fetchPaymentByHash looks up the payment paying to the target payment hash
+// using the payment hash index. If found, the key and the payment itself are
+// returned, otherwise both are nil.
+func fetchPaymentByHash(tx *bolt.Tx, payments *bolt.Bucket,
+	paymentHash [32]byte) ([]byte, *OutgoingPayment, error) {
+
+	hashIndex := tx.Bucket(paymentHashIndexBucket)
+	if hashIndex == nil {
+		return nil, nil, nil
+	}
+
+	paymentID := hashIndex.Get(paymentHash[:])
+	if paymentID == nil {
+		return nil, nil, nil
+	}
+
+	paymentBytes := payments.Get(paymentID)
+	if paymentBytes == nil {
+		return nil, nil, nil
+	}
+
+	payment, err := deserializeOutgoingPayment(bytes.NewReader(paymentBytes))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paymentKey := make([]byte, len(paymentID))
+	copy(paymentKey, paymentID)
+
+	return paymentKey, payment, nil
+}
+
+// DeletePayment deletes the outgoing payment paying to the target payment
+// hash. If no such payment exists, then ErrPaymentNotFound is returned.
+//
+// NOTE: The payment status is retained, so a payment hash that was already
+// paid can't be paid again after its payment has been deleted.
+func (db *DB) DeletePayment(paymentHash [32]byte) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		payments := tx.Bucket(paymentBucket)
+		if payments == nil {
+			return ErrNoPaymentsCreated
+		}
+
+		paymentKey, payment, err := fetchPaymentByHash(
+			tx, payments, paymentHash,
+		)
+		if err != nil {
+			return err
+		}
+		if payment == nil {
+			return ErrPaymentNotFound
+		}
+
+		return removePayments(
+			tx, payments, [][]byte{paymentKey},
+			[][32]byte{paymentHash},
+		)
+	})
+}
+
+// DeletePaymentsBefore deletes all outgoing payments which were created before
+// the passed cutoff time. As with DeletePayment, their payment statuses are
+// retained. The number of payments deleted is returned.
+func (db *DB) DeletePaymentsBefore(cutoff time.Time) (uint64, error) {
+	var numDeleted uint64
+	err := db.Update(func(tx *bolt.Tx) error {
+		payments := tx.Bucket(paymentBucket)
+		if payments == nil {
+			return ErrNoPaymentsCreated
+		}
+
+		// As a bucket can't be modified while iterating over it, we'll
+		// first collect the keys and payment hashes of all payments
+		// to be deleted.
+		var (
+			paymentKeys   [][]byte
+			paymentHashes [][32]byte
+		)
+		err := payments.ForEach(func(k, v []byte) error {
+			// If the value is nil, then we ignore it as it may be
+			// a sub-bucket.
+			if v == nil {
+				return nil
+			}
+
+			payment, err := deserializeOutgoingPayment(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			if !payment.CreationDate.Before(cutoff) {
+				return nil
+			}
+
+			paymentKey := make([]byte, len(k))
+			copy(paymentKey, k)
+
+			paymentKeys = append(paymentKeys, paymentKey)
+			paymentHashes = append(
+				paymentHashes,
+				sha256.Sum256(payment.PaymentPreimage[:]),
+			)
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		numDeleted = uint64(len(paymentKeys))
+
+		return removePayments(tx, payments, paymentKeys, paymentHashes)
+	})
+	switch {
+	// If no payments have been created, then there's nothing to delete.
+	case err == ErrNoPaymentsCreated:
+		return 0, nil
+
+	case err != nil:
+		return 0, err
+	}
+
+	return numDeleted, nil
+}
+
+// removePayments deletes the payments stored under the passed keys within the
+// payments bucket, along with their entries in the payment hash index. The
+// payment hashes must be index-aligned with the payment keys.
+//
+// NOTE: The payment status of each payment is retained, as the control tower
+// relies on it to prevent a payment hash that was already paid from being
+// paid a second time.
+func removePayments(tx *bolt.Tx, payments *bolt.Bucket, paymentKeys [][]byte,
+	paymentHashes [][32]byte) error {
+
+	hashIndex := tx.Bucket(paymentHashIndexBucket)
+
+	for i, paymentKey := range paymentKeys {
+		paymentHash := paymentHashes[i]
+
+		if err := payments.Delete(paymentKey); err != nil {
+			return err
+		}
+
+		// We'll only remove the hash index entry if it refers to this
+		// payment, as it may have been replaced by a later payment to
+		// the same hash.
+		if hashIndex == nil {
+			continue
+		}
+
+		indexedKey := hashIndex.Get(paymentHash[:])
+		if !bytes.Equal(indexedKey, paymentKey) {
+			continue
+		}
+		if err := hashIndex.Delete(paymentHash[:]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // UpdatePaymentStatus sets the payment status for outgoing/finished payments in
 // local database.
 func (db *DB) UpdatePaymentStatus(paymentHash [32]byte, status PaymentStatus) error {
@@ -207,9 +1001,87 @@ func UpdatePaymentStatusTx(tx *bolt.Tx,
 		return err
 	}
 
+	// Before writing the new status, we'll remove the payment from the
+	// index of its prior status, if it had one.
+	if oldStatus := paymentStatuses.Get(paymentHash[:]); oldStatus != nil {
+		err := removePaymentStatusIndex(tx, paymentHash, oldStatus)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := putPaymentStatusIndex(tx, paymentHash, status); err != nil {
+		return err
+	}
+
 	return paymentStatuses.Put(paymentHash[:], status.Bytes())
 }
 
+// putPaymentStatusIndex adds the payment hash to the status index under the
+// target payment status.
+func putPaymentStatusIndex(tx *bolt.Tx, paymentHash [32]byte,
+	status PaymentStatus) error {
+
+	statusIndex, err := tx.CreateBucketIfNotExists(paymentStatusIndexBucket)
+	if err != nil {
+		return err
+	}
+	statusBucket, err := statusIndex.CreateBucketIfNotExists(status.Bytes())
+	if err != nil {
+		return err
+	}
+
+	return statusBucket.Put(paymentHash[:], nil)
+}
+
+// removePaymentStatusIndex removes the payment hash from the status index
+// under the passed serialized payment status.
+func removePaymentStatusIndex(tx *bolt.Tx, paymentHash [32]byte,
+	status []byte) error {
+
+	statusIndex := tx.Bucket(paymentStatusIndexBucket)
+	if statusIndex == nil {
+		return nil
+	}
+	statusBucket := statusIndex.Bucket(status)
+	if statusBucket == nil {
+		return nil
+	}
+
+	return statusBucket.Delete(paymentHash[:])
+}
+
+// FetchPaymentsByStatus returns the payment hashes of all payments which
+// currently have the target payment status. As payments only have a status
+// explicitly recorded once they've been initiated, querying for
+// StatusGrounded will not return payments that were never attempted.
+func (db *DB) FetchPaymentsByStatus(status PaymentStatus) ([][32]byte, error) {
+	var paymentHashes [][32]byte
+	err := db.View(func(tx *bolt.Tx) error {
+		statusIndex := tx.Bucket(paymentStatusIndexBucket)
+		if statusIndex == nil {
+			return nil
+		}
+		statusBucket := statusIndex.Bucket(status.Bytes())
+		if statusBucket == nil {
+			return nil
+		}
+
+		return statusBucket.ForEach(func(k, _ []byte) error {
+			var paymentHash [32]byte
+			copy(paymentHash[:], k)
+
+			paymentHashes = append(paymentHashes, paymentHash)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paymentHashes, nil
+}
+
 // FetchPaymentStatus returns the payment status for outgoing payment.
 // If status of the payment isn't found, it will default to "StatusGrounded".
 func (db *DB) FetchPaymentStatus(paymentHash [32]byte) (PaymentStatus, error) {
@@ -285,9 +1157,91 @@ func serializeOutgoingPayment(w io.Writer, p *OutgoingPayment) error {
 		return err
 	}
 
+	// The remaining fields were added in a later version, so we'll prefix
+	// them with the serialization version.
+	if _, err := w.Write([]byte{outgoingPaymentVersion}); err != nil {
+		return err
+	}
+
+	return serializePaymentAttemptInfo(w, &p.PaymentAttemptInfo)
+}
+
+func serializePaymentAttemptInfo(w io.Writer,
+	info *PaymentAttemptInfo) error {
+
+	var scratch [4]byte
+
+	failBytes, err := info.FailDate.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if err := wire.WriteVarBytes(w, 0, failBytes); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint16(scratch[:2], uint16(info.LastFailureCode))
+	if _, err := w.Write(scratch[:2]); err != nil {
+		return err
+	}
+
+	lastError := truncatePaymentError(info.LastError)
+	if err := wire.WriteVarBytes(w, 0, lastError); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint32(scratch[:], uint32(len(info.Attempts)))
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+	for i := range info.Attempts {
+		err := serializePaymentAttempt(w, &info.Attempts[i])
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func serializePaymentAttempt(w io.Writer, a *PaymentAttempt) error {
+	var scratch [4]byte
+
+	timestampBytes, err := a.Timestamp.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if err := wire.WriteVarBytes(w, 0, timestampBytes); err != nil {
+		return err
+	}
+
+	byteOrder.PutUint32(scratch[:], uint32(len(a.Path)))
+	if _, err := w.Write(scratch[:]); err != nil {
+		return err
+	}
+	for _, hop := range a.Path {
+		if _, err := w.Write(hop[:]); err != nil {
+			return err
+		}
+	}
+
+	byteOrder.PutUint16(scratch[:2], uint16(a.FailureCode))
+	if _, err := w.Write(scratch[:2]); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, truncatePaymentError(a.Error))
+}
+
+// truncatePaymentError returns the passed error string truncated to the
+// maximum size that can be stored alongside a payment or one of its attempts.
+func truncatePaymentError(errStr string) []byte {
+	if len(errStr) > maxPaymentErrorSize {
+		errStr = errStr[:maxPaymentErrorSize]
+	}
+
+	return []byte(errStr)
+}
+
 func deserializeOutgoingPayment(r io.Reader) (*OutgoingPayment, error) {
 	var scratch [8]byte
 
@@ -326,5 +1280,110 @@ func deserializeOutgoingPayment(r io.Reader) (*OutgoingPayment, error) {
 		return nil, err
 	}
 
+	// Payments written before the introduction of the serialization
+	// version end here, in which case we're done.
+	var version [1]byte
+	_, err = io.ReadFull(r, version[:])
+	switch {
+	case err == io.EOF:
+		return p, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	if version[0] != outgoingPaymentVersion {
+		return nil, fmt.Errorf("unknown outgoing payment version: %v",
+			version[0])
+	}
+
+	err = deserializePaymentAttemptInfo(r, &p.PaymentAttemptInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	return p, nil
 }
+
+func deserializePaymentAttemptInfo(r io.Reader,
+	info *PaymentAttemptInfo) error {
+
+	var scratch [4]byte
+
+	failBytes, err := wire.ReadVarBytes(r, 0, 300, "failed")
+	if err != nil {
+		return err
+	}
+	if err := info.FailDate.UnmarshalBinary(failBytes); err != nil {
+		return err
+	}
+
+	if _, err := io.ReadFull(r, scratch[:2]); err != nil {
+		return err
+	}
+	info.LastFailureCode = lnwire.FailCode(byteOrder.Uint16(scratch[:2]))
+
+	lastError, err := wire.ReadVarBytes(
+		r, 0, maxPaymentErrorSize, "error",
+	)
+	if err != nil {
+		return err
+	}
+	info.LastError = string(lastError)
+
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return err
+	}
+	numAttempts := byteOrder.Uint32(scratch[:])
+
+	if numAttempts > 0 {
+		info.Attempts = make([]PaymentAttempt, numAttempts)
+	}
+	for i := uint32(0); i < numAttempts; i++ {
+		err := deserializePaymentAttempt(r, &info.Attempts[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deserializePaymentAttempt(r io.Reader, a *PaymentAttempt) error {
+	var scratch [4]byte
+
+	timestampBytes, err := wire.ReadVarBytes(r, 0, 300, "timestamp")
+	if err != nil {
+		return err
+	}
+	if err := a.Timestamp.UnmarshalBinary(timestampBytes); err != nil {
+		return err
+	}
+
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return err
+	}
+	pathLen := byteOrder.Uint32(scratch[:])
+
+	a.Path = make([][33]byte, pathLen)
+	for i := uint32(0); i < pathLen; i++ {
+		if _, err := io.ReadFull(r, a.Path[i][:]); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.ReadFull(r, scratch[:2]); err != nil {
+		return err
+	}
+	a.FailureCode = lnwire.FailCode(byteOrder.Uint16(scratch[:2]))
+
+	attemptErr, err := wire.ReadVarBytes(
+		r, 0, maxPaymentErrorSize, "error",
+	)
+	if err != nil {
+		return err
+	}
+	a.Error = string(attemptErr)
+
+	return nil
+}
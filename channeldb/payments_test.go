@@ -2,12 +2,14 @@ package channeldb
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"math/rand"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -35,6 +37,23 @@ func makeFakePayment() *OutgoingPayment {
 		Fee:            101,
 		Path:           fakePath,
 		TimeLockLength: 1000,
+		PaymentAttemptInfo: PaymentAttemptInfo{
+			LastFailureCode: lnwire.CodeTemporaryChannelFailure,
+			LastError:       "fake error",
+			Attempts: []PaymentAttempt{
+				{
+					Timestamp: fakeInvoice.CreationDate,
+					Path:      fakePath[:1],
+					FailureCode: lnwire.
+						CodeTemporaryChannelFailure,
+					Error: "fake error",
+				},
+				{
+					Timestamp: fakeInvoice.CreationDate,
+					Path:      fakePath,
+				},
+			},
+		},
 	}
 	copy(fakePayment.PaymentPreimage[:], rev[:])
 	return fakePayment
@@ -133,6 +152,55 @@ func TestOutgoingPaymentSerialization(t *testing.T) {
 	}
 }
 
+// TestLegacyOutgoingPaymentDeserialization tests that payments serialized
+// prior to the introduction of the serialization version can still be read.
+func TestLegacyOutgoingPaymentDeserialization(t *testing.T) {
+	t.Parallel()
+
+	fakePayment := makeFakePayment()
+
+	var b bytes.Buffer
+	if err := serializeOutgoingPayment(&b, fakePayment); err != nil {
+		t.Fatalf("unable to serialize outgoing payment: %v", err)
+	}
+
+	// The legacy format ends directly after the payment preimage, so
+	// we'll strip all versioned fields from the serialized payment.
+	legacyBytes := b.Bytes()[:legacyPaymentLen(fakePayment)]
+
+	newPayment, err := deserializeOutgoingPayment(
+		bytes.NewReader(legacyBytes),
+	)
+	if err != nil {
+		t.Fatalf("unable to deserialize outgoing payment: %v", err)
+	}
+
+	// The versioned fields should be left empty.
+	fakePayment.LastFailureCode = 0
+	fakePayment.LastError = ""
+	fakePayment.Attempts = nil
+	if !reflect.DeepEqual(fakePayment, newPayment) {
+		t.Fatalf("Payments do not match after "+
+			"serialization/deserialization %v vs %v",
+			spew.Sdump(fakePayment),
+			spew.Sdump(newPayment),
+		)
+	}
+}
+
+// legacyPaymentLen returns the length of the payment when serialized in the
+// format used prior to the introduction of the serialization version.
+func legacyPaymentLen(p *OutgoingPayment) int {
+	var b bytes.Buffer
+	if err := serializeInvoice(&b, &p.Invoice); err != nil {
+		panic(err)
+	}
+
+	// The invoice is followed by the fee, the path length and the path
+	// itself, the time lock length, and finally the preimage.
+	return b.Len() + 8 + 4 + len(p.Path)*33 + 4 + 32
+}
+
 func TestOutgoingPaymentWorkflow(t *testing.T) {
 	t.Parallel()
 
@@ -251,3 +319,687 @@ func TestPaymentStatusWorkflow(t *testing.T) {
 		}
 	}
 }
+
+// TestQueryPayments tests that payments can be paginated in both directions,
+// and filtered by their creation date and status.
+func TestQueryPayments(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Querying an empty database should return an empty response.
+	resp, err := db.QueryPayments(PaymentsQuery{NumMaxPayments: 10})
+	if err != nil {
+		t.Fatalf("unable to query payments: %v", err)
+	}
+	if len(resp.Payments) != 0 {
+		t.Fatalf("expected no payments, got %v", len(resp.Payments))
+	}
+
+	// We'll add ten payments, each created one hour after the previous.
+	// Every other payment will be marked as completed.
+	const numPayments = 10
+	baseTime := time.Unix(time.Now().Unix(), 0)
+	payments := make([]*OutgoingPayment, 0, numPayments)
+	for i := 0; i < numPayments; i++ {
+		payment, err := makeRandomFakePayment()
+		if err != nil {
+			t.Fatalf("unable to create payment: %v", err)
+		}
+		payment.CreationDate = baseTime.Add(time.Duration(i) * time.Hour)
+
+		if err := db.AddPayment(payment); err != nil {
+			t.Fatalf("unable to add payment: %v", err)
+		}
+
+		if i%2 == 0 {
+			paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
+			err := db.UpdatePaymentStatus(
+				paymentHash, StatusCompleted,
+			)
+			if err != nil {
+				t.Fatalf("unable to update payment status: %v",
+					err)
+			}
+		}
+
+		payments = append(payments, payment)
+	}
+
+	testCases := []struct {
+		query      PaymentsQuery
+		expected   []*OutgoingPayment
+		firstIndex uint64
+		lastIndex  uint64
+	}{
+		// Fetch all payments with a single query.
+		{
+			query: PaymentsQuery{
+				NumMaxPayments: numPayments,
+			},
+			expected:   payments,
+			firstIndex: 1,
+			lastIndex:  10,
+		},
+		// Fetch the first three payments.
+		{
+			query: PaymentsQuery{
+				NumMaxPayments: 3,
+			},
+			expected:   payments[:3],
+			firstIndex: 1,
+			lastIndex:  3,
+		},
+		// Fetch the three payments following the fifth.
+		{
+			query: PaymentsQuery{
+				IndexOffset:    5,
+				NumMaxPayments: 3,
+			},
+			expected:   payments[5:8],
+			firstIndex: 6,
+			lastIndex:  8,
+		},
+		// Fetch the last three payments in reverse.
+		{
+			query: PaymentsQuery{
+				NumMaxPayments: 3,
+				Reversed:       true,
+			},
+			expected:   payments[7:],
+			firstIndex: 8,
+			lastIndex:  10,
+		},
+		// Fetch the three payments preceding the fifth in reverse.
+		{
+			query: PaymentsQuery{
+				IndexOffset:    5,
+				NumMaxPayments: 3,
+				Reversed:       true,
+			},
+			expected:   payments[1:4],
+			firstIndex: 2,
+			lastIndex:  4,
+		},
+		// Seeking past the end in reverse should start from the last
+		// payment.
+		{
+			query: PaymentsQuery{
+				IndexOffset:    20,
+				NumMaxPayments: 2,
+				Reversed:       true,
+			},
+			expected:   payments[8:],
+			firstIndex: 9,
+			lastIndex:  10,
+		},
+		// Fetch only the payments created within a three hour window.
+		{
+			query: PaymentsQuery{
+				NumMaxPayments: numPayments,
+				StartTime:      baseTime.Add(2 * time.Hour),
+				EndTime:        baseTime.Add(4 * time.Hour),
+			},
+			expected:   payments[2:5],
+			firstIndex: 3,
+			lastIndex:  5,
+		},
+		// Fetch only the payments that haven't been completed.
+		{
+			query: PaymentsQuery{
+				NumMaxPayments: 3,
+				Statuses:       []PaymentStatus{StatusGrounded},
+			},
+			expected: []*OutgoingPayment{
+				payments[1], payments[3], payments[5],
+			},
+			firstIndex: 2,
+			lastIndex:  6,
+		},
+	}
+
+	for i, testCase := range testCases {
+		resp, err := db.QueryPayments(testCase.query)
+		if err != nil {
+			t.Fatalf("test #%d: unable to query payments: %v",
+				i, err)
+		}
+
+		if !reflect.DeepEqual(resp.Payments, testCase.expected) {
+			t.Fatalf("test #%d: query returned incorrect set of "+
+				"payments: expected %v, got %v", i,
+				spew.Sdump(testCase.expected),
+				spew.Sdump(resp.Payments))
+		}
+
+		if resp.FirstIndexOffset != testCase.firstIndex {
+			t.Fatalf("test #%d: expected first index %v, got %v",
+				i, testCase.firstIndex, resp.FirstIndexOffset)
+		}
+		if resp.LastIndexOffset != testCase.lastIndex {
+			t.Fatalf("test #%d: expected last index %v, got %v",
+				i, testCase.lastIndex, resp.LastIndexOffset)
+		}
+	}
+}
+
+// TestDeletePayments tests that individual payments can be deleted by their
+// payment hash, and that payments can be pruned by their creation date.
+func TestDeletePayments(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Deleting from an empty database should fail for a specific payment,
+	// but be a noop when pruning.
+	if err := db.DeletePayment(makeFakePaymentHash()); err != ErrNoPaymentsCreated {
+		t.Fatalf("expected ErrNoPaymentsCreated, got %v", err)
+	}
+	numDeleted, err := db.DeletePaymentsBefore(time.Now())
+	if err != nil {
+		t.Fatalf("unable to prune payments: %v", err)
+	}
+	if numDeleted != 0 {
+		t.Fatalf("expected no payments to be deleted, got %v",
+			numDeleted)
+	}
+
+	// We'll add five payments, each created one hour after the previous,
+	// and mark each of them as completed.
+	const numPayments = 5
+	baseTime := time.Unix(time.Now().Unix(), 0)
+	payments := make([]*OutgoingPayment, 0, numPayments)
+	for i := 0; i < numPayments; i++ {
+		payment, err := makeRandomFakePayment()
+		if err != nil {
+			t.Fatalf("unable to create payment: %v", err)
+		}
+		payment.CreationDate = baseTime.Add(time.Duration(i) * time.Hour)
+
+		if err := db.AddPayment(payment); err != nil {
+			t.Fatalf("unable to add payment: %v", err)
+		}
+
+		paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
+		err = db.UpdatePaymentStatus(paymentHash, StatusCompleted)
+		if err != nil {
+			t.Fatalf("unable to update payment status: %v", err)
+		}
+
+		payments = append(payments, payment)
+	}
+
+	// Delete the third payment. It should no longer be returned, but its
+	// status should be retained so that it can't be paid again.
+	paymentHash := sha256.Sum256(payments[2].PaymentPreimage[:])
+	if err := db.DeletePayment(paymentHash); err != nil {
+		t.Fatalf("unable to delete payment: %v", err)
+	}
+	status, err := db.FetchPaymentStatus(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment status: %v", err)
+	}
+	if status != StatusCompleted {
+		t.Fatalf("expected status %v, got %v", StatusCompleted, status)
+	}
+
+	// Deleting the payment a second time should fail.
+	if err := db.DeletePayment(paymentHash); err != ErrPaymentNotFound {
+		t.Fatalf("expected ErrPaymentNotFound, got %v", err)
+	}
+
+	dbPayments, err := db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+	expected := []*OutgoingPayment{
+		payments[0], payments[1], payments[3], payments[4],
+	}
+	if !reflect.DeepEqual(dbPayments, expected) {
+		t.Fatalf("wrong payments after deletion: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(dbPayments))
+	}
+
+	// Now, prune all payments created before the fourth payment. This
+	// should only delete the first two, as the third was already deleted.
+	numDeleted, err = db.DeletePaymentsBefore(payments[3].CreationDate)
+	if err != nil {
+		t.Fatalf("unable to prune payments: %v", err)
+	}
+	if numDeleted != 2 {
+		t.Fatalf("expected 2 payments to be deleted, got %v",
+			numDeleted)
+	}
+
+	dbPayments, err = db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+	expected = payments[3:]
+	if !reflect.DeepEqual(dbPayments, expected) {
+		t.Fatalf("wrong payments after pruning: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(dbPayments))
+	}
+
+	// The pruned payments should also have been removed from the payment
+	// hash index, while the remaining ones can still be deleted by hash.
+	// The status of every payment should still be completed.
+	err = db.View(func(tx *bolt.Tx) error {
+		hashIndex := tx.Bucket(paymentHashIndexBucket)
+		for i, payment := range payments {
+			paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
+			indexed := hashIndex.Get(paymentHash[:]) != nil
+			if indexed != (i >= 3) {
+				return fmt.Errorf("payment %v: expected "+
+					"indexed=%v, got %v", i, i >= 3,
+					indexed)
+			}
+
+			status, err := FetchPaymentStatusTx(tx, paymentHash)
+			if err != nil {
+				return err
+			}
+			if status != StatusCompleted {
+				return fmt.Errorf("payment %v: expected "+
+					"status %v, got %v", i,
+					StatusCompleted, status)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paymentHash = sha256.Sum256(payments[4].PaymentPreimage[:])
+	if err := db.DeletePayment(paymentHash); err != nil {
+		t.Fatalf("unable to delete payment: %v", err)
+	}
+}
+
+// TestFetchPaymentsByStatus tests that the payment status index is kept up to
+// date as payments transition between statuses, and isn't affected by the
+// deletion of payments.
+func TestFetchPaymentsByStatus(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	assertPaymentsByStatus := func(status PaymentStatus,
+		expected ...[32]byte) {
+
+		t.Helper()
+
+		paymentHashes, err := db.FetchPaymentsByStatus(status)
+		if err != nil {
+			t.Fatalf("unable to fetch payments by status: %v", err)
+		}
+
+		if len(paymentHashes) != len(expected) {
+			t.Fatalf("expected %v payments with status %v, got %v",
+				len(expected), status, len(paymentHashes))
+		}
+
+		found := make(map[[32]byte]struct{})
+		for _, paymentHash := range paymentHashes {
+			found[paymentHash] = struct{}{}
+		}
+		for _, paymentHash := range expected {
+			if _, ok := found[paymentHash]; !ok {
+				t.Fatalf("payment %x not found with status %v",
+					paymentHash[:], status)
+			}
+		}
+	}
+
+	// Initially, no payments should be in flight.
+	assertPaymentsByStatus(StatusInFlight)
+
+	// We'll mark two payments as in flight.
+	hash1, hash2 := makeFakePaymentHash(), makeFakePaymentHash()
+	for _, paymentHash := range [][32]byte{hash1, hash2} {
+		err := db.UpdatePaymentStatus(paymentHash, StatusInFlight)
+		if err != nil {
+			t.Fatalf("unable to update payment status: %v", err)
+		}
+	}
+	assertPaymentsByStatus(StatusInFlight, hash1, hash2)
+
+	// Transitioning the first payment to completed should remove it from
+	// the set of in flight payments.
+	if err := db.UpdatePaymentStatus(hash1, StatusCompleted); err != nil {
+		t.Fatalf("unable to update payment status: %v", err)
+	}
+	assertPaymentsByStatus(StatusInFlight, hash2)
+	assertPaymentsByStatus(StatusCompleted, hash1)
+
+	// Transitioning the second payment back to grounded should leave no
+	// payments in flight.
+	if err := db.UpdatePaymentStatus(hash2, StatusGrounded); err != nil {
+		t.Fatalf("unable to update payment status: %v", err)
+	}
+	assertPaymentsByStatus(StatusInFlight)
+	assertPaymentsByStatus(StatusGrounded, hash2)
+
+	// Finally, deleting a completed payment should leave it within the
+	// index, as its status is retained.
+	payment, err := makeRandomFakePayment()
+	if err != nil {
+		t.Fatalf("unable to create payment: %v", err)
+	}
+	if err := db.AddPayment(payment); err != nil {
+		t.Fatalf("unable to add payment: %v", err)
+	}
+	hash3 := sha256.Sum256(payment.PaymentPreimage[:])
+	if err := db.UpdatePaymentStatus(hash3, StatusCompleted); err != nil {
+		t.Fatalf("unable to update payment status: %v", err)
+	}
+	assertPaymentsByStatus(StatusCompleted, hash1, hash3)
+
+	if err := db.DeletePayment(hash3); err != nil {
+		t.Fatalf("unable to delete payment: %v", err)
+	}
+	assertPaymentsByStatus(StatusCompleted, hash1, hash3)
+}
+
+// TestUpdatePayment tests that UpdatePayment atomically applies changes to
+// both a payment and its status, and that no changes are made if the update
+// fails.
+func TestUpdatePayment(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	payment, err := makeRandomFakePayment()
+	if err != nil {
+		t.Fatalf("unable to create payment: %v", err)
+	}
+	paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
+
+	// We'll first mark the payment as in flight. As the preimage isn't
+	// yet known, no payment should be written.
+	err = db.UpdatePayment(paymentHash,
+		func(p *OutgoingPayment, status *PaymentStatus) error {
+			*status = StatusInFlight
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to update payment: %v", err)
+	}
+
+	status, err := db.FetchPaymentStatus(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment status: %v", err)
+	}
+	if status != StatusInFlight {
+		t.Fatalf("expected status %v, got %v", StatusInFlight, status)
+	}
+	payments, err := db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+	if len(payments) != 0 {
+		t.Fatalf("expected no payments, got %v", len(payments))
+	}
+
+	// An update which fails should leave both the payment and its status
+	// untouched.
+	updateErr := fmt.Errorf("update failed")
+	err = db.UpdatePayment(paymentHash,
+		func(p *OutgoingPayment, status *PaymentStatus) error {
+			*p = *payment
+			*status = StatusCompleted
+			return updateErr
+		},
+	)
+	if err != updateErr {
+		t.Fatalf("expected update error, got %v", err)
+	}
+	status, err = db.FetchPaymentStatus(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment status: %v", err)
+	}
+	if status != StatusInFlight {
+		t.Fatalf("expected status %v, got %v", StatusInFlight, status)
+	}
+
+	// Writing a payment whose preimage doesn't match the payment hash
+	// should fail.
+	err = db.UpdatePayment(makeFakePaymentHash(),
+		func(p *OutgoingPayment, status *PaymentStatus) error {
+			*p = *payment
+			return nil
+		},
+	)
+	if err != ErrPaymentHashMismatch {
+		t.Fatalf("expected ErrPaymentHashMismatch, got %v", err)
+	}
+
+	// Now, we'll record the successful payment and mark it completed in a
+	// single update.
+	err = db.UpdatePayment(paymentHash,
+		func(p *OutgoingPayment, status *PaymentStatus) error {
+			*p = *payment
+			*status = StatusCompleted
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to update payment: %v", err)
+	}
+
+	status, err = db.FetchPaymentStatus(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment status: %v", err)
+	}
+	if status != StatusCompleted {
+		t.Fatalf("expected status %v, got %v", StatusCompleted, status)
+	}
+	payments, err = db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+	if !reflect.DeepEqual(payments, []*OutgoingPayment{payment}) {
+		t.Fatalf("wrong payments: expected %v, got %v",
+			spew.Sdump(payment), spew.Sdump(payments))
+	}
+
+	// Finally, modifying the existing payment should update it in place
+	// rather than adding a new payment.
+	err = db.UpdatePayment(paymentHash,
+		func(p *OutgoingPayment, status *PaymentStatus) error {
+			p.Fee++
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to update payment: %v", err)
+	}
+
+	payment.Fee++
+	payments, err = db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+	if !reflect.DeepEqual(payments, []*OutgoingPayment{payment}) {
+		t.Fatalf("wrong payments: expected %v, got %v",
+			spew.Sdump(payment), spew.Sdump(payments))
+	}
+
+	// Payments added through AddPayment should also be located through
+	// the payment hash index, and be updated in place.
+	addedPayment, err := makeRandomFakePayment()
+	if err != nil {
+		t.Fatalf("unable to create payment: %v", err)
+	}
+	if err := db.AddPayment(addedPayment); err != nil {
+		t.Fatalf("unable to add payment: %v", err)
+	}
+
+	addedHash := sha256.Sum256(addedPayment.PaymentPreimage[:])
+	err = db.UpdatePayment(addedHash,
+		func(p *OutgoingPayment, status *PaymentStatus) error {
+			if !reflect.DeepEqual(p, addedPayment) {
+				return fmt.Errorf("wrong payment: expected "+
+					"%v, got %v", spew.Sdump(addedPayment),
+					spew.Sdump(p))
+			}
+
+			p.Fee++
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to update payment: %v", err)
+	}
+
+	addedPayment.Fee++
+	payments, err = db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+	expectedPayments := []*OutgoingPayment{payment, addedPayment}
+	if !reflect.DeepEqual(payments, expectedPayments) {
+		t.Fatalf("wrong payments: expected %v, got %v",
+			spew.Sdump(expectedPayments), spew.Sdump(payments))
+	}
+}
+
+// TestPaymentAttempts tests that attempts recorded for a payment hash are
+// retained while the payment hasn't succeeded, and are moved onto the payment
+// once it's added.
+func TestPaymentAttempts(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	payment, err := makeRandomFakePayment()
+	if err != nil {
+		t.Fatalf("unable to create payment: %v", err)
+	}
+	paymentHash := sha256.Sum256(payment.PaymentPreimage[:])
+
+	_, err = db.FetchPaymentAttempts(paymentHash)
+	if err != ErrPaymentNotFound {
+		t.Fatalf("expected ErrPaymentNotFound, got %v", err)
+	}
+
+	// We'll record a failed attempt, followed by the failure of the
+	// payment as a whole.
+	startTime := payment.CreationDate.Add(-time.Hour)
+	failedAttempt := PaymentAttempt{
+		Timestamp:   startTime,
+		Path:        payment.Path,
+		FailureCode: lnwire.CodeUnknownNextPeer,
+		Error:       "unknown next peer",
+	}
+	err = db.AddPaymentAttempt(paymentHash, &failedAttempt)
+	if err != nil {
+		t.Fatalf("unable to add payment attempt: %v", err)
+	}
+
+	failDate := startTime.Add(time.Minute)
+	err = db.FailPayment(paymentHash, failDate, "no route found")
+	if err != nil {
+		t.Fatalf("unable to fail payment: %v", err)
+	}
+
+	assertAttempts := func(expected *PaymentAttemptInfo) {
+		t.Helper()
+
+		info, err := db.FetchPaymentAttempts(paymentHash)
+		if err != nil {
+			t.Fatalf("unable to fetch payment attempts: %v", err)
+		}
+		if !reflect.DeepEqual(info, expected) {
+			t.Fatalf("wrong payment attempts: expected %v, got %v",
+				spew.Sdump(expected), spew.Sdump(info))
+		}
+	}
+
+	assertAttempts(&PaymentAttemptInfo{
+		FailDate:        failDate,
+		LastFailureCode: lnwire.CodeUnknownNextPeer,
+		LastError:       "no route found",
+		Attempts:        []PaymentAttempt{failedAttempt},
+	})
+
+	// Retrying the payment should clear its fail date, while a successful
+	// attempt shouldn't replace the last failure.
+	successAttempt := PaymentAttempt{
+		Timestamp: failDate.Add(time.Minute),
+		Path:      payment.Path,
+	}
+	err = db.AddPaymentAttempt(paymentHash, &successAttempt)
+	if err != nil {
+		t.Fatalf("unable to add payment attempt: %v", err)
+	}
+
+	expectedInfo := PaymentAttemptInfo{
+		LastFailureCode: lnwire.CodeUnknownNextPeer,
+		LastError:       "no route found",
+		Attempts: []PaymentAttempt{
+			failedAttempt, successAttempt,
+		},
+	}
+	assertAttempts(&expectedInfo)
+
+	// Once the payment is added, the attempts should be moved onto it, and
+	// it should take on the creation date of its first attempt.
+	if err := db.AddPayment(payment); err != nil {
+		t.Fatalf("unable to add payment: %v", err)
+	}
+
+	payment.PaymentAttemptInfo = expectedInfo
+	payment.CreationDate = startTime
+
+	payments, err := db.FetchAllPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch payments: %v", err)
+	}
+	if !reflect.DeepEqual(payments, []*OutgoingPayment{payment}) {
+		t.Fatalf("wrong payments: expected %v, got %v",
+			spew.Sdump(payment), spew.Sdump(payments))
+	}
+	assertAttempts(&expectedInfo)
+
+	err = db.View(func(tx *bolt.Tx) error {
+		attempts := tx.Bucket(paymentAttemptsBucket)
+		if attempts.Get(paymentHash[:]) != nil {
+			return fmt.Errorf("payment attempts weren't removed")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Further attempts for the paid payment hash should be ignored.
+	err = db.AddPaymentAttempt(paymentHash, &failedAttempt)
+	if err != nil {
+		t.Fatalf("unable to add payment attempt: %v", err)
+	}
+	assertAttempts(&expectedInfo)
+}
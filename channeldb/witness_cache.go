@@ -46,8 +46,183 @@ var (
 	// witnesses encountered. Within this bucket, we'll create a sub-bucket for
 	// each witness type.
 	witnessBucketKey = []byte("byte")
+
+	// witnessAddIndexBucketKey is the name of the sub-bucket within each
+	// witness type bucket that we use to record the order in which
+	// witnesses were added. Each time a new witness is added, the
+	// sequence number of this bucket will be incremented, and we'll map:
+	//
+	//   addIndexNo => witnessKey
+	//
+	// This allows callers to replay all witnesses added after a
+	// particular checkpoint.
+	witnessAddIndexBucketKey = []byte("witness-add-index")
+
+	// witnessExpiryIndexBucketKey is the name of the sub-bucket within
+	// each witness type bucket that we use to track the height after
+	// which a witness may be pruned from the cache. Keys within this
+	// bucket have the following structure:
+	//
+	//   expiryHeight || witnessKey => nil
+	//
+	// As the height is stored in big-endian, a cursor can be used to
+	// efficiently find all witnesses that expire before a given height.
+	witnessExpiryIndexBucketKey = []byte("witness-expiry-index")
+
+	// witnessStateBucketKey is the name of the sub-bucket within each
+	// witness type bucket that we use to map each witness to its entries
+	// within the add and expiry indexes:
+	//
+	//   witnessKey => addIndexNo || expiryHeight
+	//
+	// This allows both index entries to be located when a witness is
+	// removed from the cache, or its expiry is changed.
+	witnessStateBucketKey = []byte("witness-state")
 )
 
+// witnessState tracks the entries of a single witness within the add and
+// expiry indexes.
+type witnessState struct {
+	// addIndex is the sequence number of the witness within the add
+	// index. This is zero for witnesses that were added before the add
+	// index existed.
+	addIndex uint64
+
+	// expiryHeight is the height after which the witness may be pruned.
+	// This is zero if the witness hasn't been marked to expire.
+	expiryHeight uint32
+}
+
+// fetchWitnessState returns the state of the target witness within the passed
+// witness type bucket. If no state is stored for the witness, then nil is
+// returned.
+func fetchWitnessState(witnessTypeBucket *bolt.Bucket,
+	witnessKey []byte) *witnessState {
+
+	stateBucket := witnessTypeBucket.Bucket(witnessStateBucketKey)
+	if stateBucket == nil {
+		return nil
+	}
+
+	stateBytes := stateBucket.Get(witnessKey)
+	if len(stateBytes) != 12 {
+		return nil
+	}
+
+	return &witnessState{
+		addIndex:     byteOrder.Uint64(stateBytes[:8]),
+		expiryHeight: byteOrder.Uint32(stateBytes[8:]),
+	}
+}
+
+// putWitnessState stores the state of the target witness within the passed
+// witness type bucket.
+func putWitnessState(witnessTypeBucket *bolt.Bucket, witnessKey []byte,
+	state *witnessState) error {
+
+	stateBucket, err := witnessTypeBucket.CreateBucketIfNotExists(
+		witnessStateBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	var stateBytes [12]byte
+	byteOrder.PutUint64(stateBytes[:8], state.addIndex)
+	byteOrder.PutUint32(stateBytes[8:], state.expiryHeight)
+
+	return stateBucket.Put(witnessKey, stateBytes[:])
+}
+
+// expiryIndexKey returns the key of the target witness within the expiry
+// index, given the height at which it expires.
+func expiryIndexKey(witnessKey []byte, expiryHeight uint32) []byte {
+	expiryKey := make([]byte, 4+len(witnessKey))
+	byteOrder.PutUint32(expiryKey[:4], expiryHeight)
+	copy(expiryKey[4:], witnessKey)
+
+	return expiryKey
+}
+
+// updateWitnessExpiry replaces the expiry height of the target witness,
+// updating its entry within the expiry index. An expiry height of zero clears
+// any existing expiry.
+func updateWitnessExpiry(witnessTypeBucket *bolt.Bucket, witnessKey []byte,
+	state *witnessState, expiryHeight uint32) error {
+
+	expiryIndex, err := witnessTypeBucket.CreateBucketIfNotExists(
+		witnessExpiryIndexBucketKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	if state.expiryHeight != 0 {
+		err := expiryIndex.Delete(
+			expiryIndexKey(witnessKey, state.expiryHeight),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if expiryHeight != 0 {
+		err := expiryIndex.Put(
+			expiryIndexKey(witnessKey, expiryHeight), nil,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	state.expiryHeight = expiryHeight
+
+	return putWitnessState(witnessTypeBucket, witnessKey, state)
+}
+
+// removeWitness deletes the target witness from the passed witness type
+// bucket, along with its state and its entries within the add and expiry
+// indexes.
+func removeWitness(witnessTypeBucket *bolt.Bucket, witnessKey []byte) error {
+	if err := witnessTypeBucket.Delete(witnessKey); err != nil {
+		return err
+	}
+
+	state := fetchWitnessState(witnessTypeBucket, witnessKey)
+	if state == nil {
+		return nil
+	}
+
+	if state.addIndex != 0 {
+		addIndex := witnessTypeBucket.Bucket(witnessAddIndexBucketKey)
+		if addIndex != nil {
+			var seqNoBytes [8]byte
+			byteOrder.PutUint64(seqNoBytes[:], state.addIndex)
+			if err := addIndex.Delete(seqNoBytes[:]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if state.expiryHeight != 0 {
+		expiryIndex := witnessTypeBucket.Bucket(
+			witnessExpiryIndexBucketKey,
+		)
+		if expiryIndex != nil {
+			err := expiryIndex.Delete(
+				expiryIndexKey(witnessKey, state.expiryHeight),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return witnessTypeBucket.Bucket(witnessStateBucketKey).Delete(
+		witnessKey,
+	)
+}
+
 // WitnessCache is a persistent cache of all witnesses we've encountered on the
 // network. In the case of multi-hop, multi-step contracts, a cache of all
 // witnesses can be useful in the case of partial contract resolution. If
@@ -57,9 +232,22 @@ var (
 // Additionally, as one MUST always use a unique witness on the network, we may
 // use this cache to detect duplicate witnesses.
 //
-// TODO(roasbeef): need expiry policy?
-//  * encrypt?
+// In order to bound the size of the cache, witnesses can be marked to expire
+// at a particular height once the contract they relate to has been resolved.
+// Expired witnesses are removed by PruneWitnesses, along with the oldest
+// witnesses marked to expire in excess of MaxEntries. If a witness is added
+// again after being marked to expire, then its expiry is cleared, as it may
+// now be required to resolve a new contract.
+//
+// TODO(roasbeef): encrypt?
 type WitnessCache struct {
+	// MaxEntries is the maximum number of witnesses of each type that
+	// will be retained after a call to PruneWitnesses. Only witnesses
+	// which have been marked to expire are evicted to satisfy this limit,
+	// as the others may still be required to resolve a contract. If zero,
+	// then only expired witnesses are pruned.
+	MaxEntries uint64
+
 	db *DB
 }
 
@@ -76,6 +264,21 @@ func (d *DB) NewWitnessCache() *WitnessCache {
 //
 // TODO(roasbeef): fake closure to map instead a constructor?
 func (w *WitnessCache) AddWitness(wType WitnessType, witness []byte) error {
+	return w.AddWitnesses(wType, witness)
+}
+
+// AddWitnesses adds a batch of new witnesses of wType to the witness cache
+// within a single database transaction. The type of the witnesses will be
+// used to map each witness to the key that will be used to look it up.
+func (w *WitnessCache) AddWitnesses(wType WitnessType,
+	witnesses ...[]byte) error {
+
+	// If there aren't any witnesses to add, then we'll exit early to
+	// avoid an unnecessary database transaction.
+	if len(witnesses) == 0 {
+		return nil
+	}
+
 	return w.db.Batch(func(tx *bolt.Tx) error {
 		witnessBucket, err := tx.CreateBucketIfNotExists(witnessBucketKey)
 		if err != nil {
@@ -93,17 +296,151 @@ func (w *WitnessCache) AddWitness(wType WitnessType, witness []byte) error {
 			return err
 		}
 
-		// Now that we have the proper bucket for this witness, we'll map the
-		// witness type to the proper key.
-		var witnessKey []byte
-		switch wType {
-		case Sha256HashWitness:
-			key := sha256.Sum256(witness)
-			witnessKey = key[:]
+		addIndex, err := witnessTypeBucket.CreateBucketIfNotExists(
+			witnessAddIndexBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, witness := range witnesses {
+			// Now that we have the proper bucket for this witness,
+			// we'll map the witness type to the proper key.
+			var witnessKey []byte
+			switch wType {
+			case Sha256HashWitness:
+				key := sha256.Sum256(witness)
+				witnessKey = key[:]
+			}
+
+			// If we already know of this witness, then we'll avoid
+			// adding a duplicate entry to the add index. However,
+			// as it may now be required to resolve another
+			// contract, we'll clear any pending expiry.
+			if witnessTypeBucket.Get(witnessKey) != nil {
+				state := fetchWitnessState(
+					witnessTypeBucket, witnessKey,
+				)
+				if state == nil || state.expiryHeight == 0 {
+					continue
+				}
+
+				err := updateWitnessExpiry(
+					witnessTypeBucket, witnessKey, state, 0,
+				)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			// Otherwise, we'll record the witness within the add
+			// index so it can be replayed to callers that missed
+			// it.
+			nextAddSeqNo, err := addIndex.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			var seqNoBytes [8]byte
+			byteOrder.PutUint64(seqNoBytes[:], nextAddSeqNo)
+			err = addIndex.Put(seqNoBytes[:], witnessKey)
+			if err != nil {
+				return err
+			}
+
+			err = witnessTypeBucket.Put(witnessKey, witness)
+			if err != nil {
+				return err
+			}
+
+			err = putWitnessState(
+				witnessTypeBucket, witnessKey,
+				&witnessState{addIndex: nextAddSeqNo},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// WitnessesAddedSince returns all witnesses of wType which were added to the
+// cache after the specified sinceAddIndex, in the order they were added. The
+// add index of the most recently added witness is also returned, which can be
+// used by callers as the checkpoint for a subsequent call. Witnesses which
+// were deleted after being added are skipped.
+//
+// NOTE: The add index starts from 1, so a sinceAddIndex of zero will return
+// all witnesses recorded within the add index.
+func (w *WitnessCache) WitnessesAddedSince(wType WitnessType,
+	sinceAddIndex uint64) ([][]byte, uint64, error) {
+
+	var (
+		witnesses    [][]byte
+		lastAddIndex = sinceAddIndex
+	)
+	err := w.db.View(func(tx *bolt.Tx) error {
+		witnessBucket := tx.Bucket(witnessBucketKey)
+		if witnessBucket == nil {
+			return ErrNoWitnesses
+		}
+
+		witnessTypeBucketKey, err := wType.toDBKey()
+		if err != nil {
+			return err
+		}
+		witnessTypeBucket := witnessBucket.Bucket(witnessTypeBucketKey)
+		if witnessTypeBucket == nil {
+			return ErrNoWitnesses
+		}
+
+		addIndex := witnessTypeBucket.Bucket(witnessAddIndexBucketKey)
+		if addIndex == nil {
+			return ErrNoWitnesses
+		}
+
+		// The current sequence of the add index is the index of the
+		// last witness added, regardless of whether it has since been
+		// deleted.
+		if seqNo := addIndex.Sequence(); seqNo > lastAddIndex {
+			lastAddIndex = seqNo
+		}
+
+		// We'll seek to the first entry after the since add index,
+		// then collect each witness until we reach the end of the
+		// index.
+		var startIndex [8]byte
+		byteOrder.PutUint64(startIndex[:], sinceAddIndex+1)
+
+		c := addIndex.Cursor()
+		for k, witnessKey := c.Seek(startIndex[:]); k != nil; k, witnessKey = c.Next() {
+			dbWitness := witnessTypeBucket.Get(witnessKey)
+			if dbWitness == nil {
+				continue
+			}
+
+			witness := make([]byte, len(dbWitness))
+			copy(witness[:], dbWitness)
+
+			witnesses = append(witnesses, witness)
 		}
 
-		return witnessTypeBucket.Put(witnessKey, witness)
+		return nil
 	})
+	switch {
+	// If no witnesses have been added yet, then we'll return the empty
+	// set.
+	case err == ErrNoWitnesses:
+
+	case err != nil:
+		return nil, 0, err
+	}
+
+	return witnesses, lastAddIndex, nil
 }
 
 // LookupWitness attempts to lookup a witness according to its type and also
@@ -143,6 +480,50 @@ func (w *WitnessCache) LookupWitness(wType WitnessType, witnessKey []byte) ([]by
 	return witness, nil
 }
 
+// LookupWitnesses attempts to lookup a batch of witnesses of wType according
+// to their witness keys within a single database transaction. The returned
+// slice is index-aligned with the passed keys, and contains a nil entry for
+// each witness that isn't found.
+func (w *WitnessCache) LookupWitnesses(wType WitnessType,
+	witnessKeys [][]byte) ([][]byte, error) {
+
+	witnesses := make([][]byte, len(witnessKeys))
+	err := w.db.View(func(tx *bolt.Tx) error {
+		witnessBucket := tx.Bucket(witnessBucketKey)
+		if witnessBucket == nil {
+			return nil
+		}
+
+		witnessTypeBucketKey, err := wType.toDBKey()
+		if err != nil {
+			return err
+		}
+		witnessTypeBucket := witnessBucket.Bucket(witnessTypeBucketKey)
+		if witnessTypeBucket == nil {
+			return nil
+		}
+
+		for i, witnessKey := range witnessKeys {
+			dbWitness := witnessTypeBucket.Get(witnessKey)
+			if dbWitness == nil {
+				continue
+			}
+
+			witness := make([]byte, len(dbWitness))
+			copy(witness[:], dbWitness)
+
+			witnesses[i] = witness
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return witnesses, nil
+}
+
 // DeleteWitness attempts to delete a particular witness from the database.
 func (w *WitnessCache) DeleteWitness(wType WitnessType, witnessKey []byte) error {
 	return w.db.Batch(func(tx *bolt.Tx) error {
@@ -162,10 +543,182 @@ func (w *WitnessCache) DeleteWitness(wType WitnessType, witnessKey []byte) error
 			return err
 		}
 
-		return witnessTypeBucket.Delete(witnessKey)
+		return removeWitness(witnessTypeBucket, witnessKey)
 	})
 }
 
+// SetWitnessExpiry marks the witness of wType identified by witnessKey to be
+// removed from the cache by the first call to PruneWitnesses with a height
+// above expiryHeight. This is typically called once the contract the witness
+// relates to has been fully resolved. If the witness is already marked to
+// expire at a later height, then its expiry is left unchanged, as another
+// contract may still require it until then. If the witness isn't found,
+// ErrNoWitnesses is returned.
+func (w *WitnessCache) SetWitnessExpiry(wType WitnessType, witnessKey []byte,
+	expiryHeight uint32) error {
+
+	return w.db.Batch(func(tx *bolt.Tx) error {
+		witnessBucket := tx.Bucket(witnessBucketKey)
+		if witnessBucket == nil {
+			return ErrNoWitnesses
+		}
+
+		witnessTypeBucketKey, err := wType.toDBKey()
+		if err != nil {
+			return err
+		}
+		witnessTypeBucket := witnessBucket.Bucket(witnessTypeBucketKey)
+		if witnessTypeBucket == nil {
+			return ErrNoWitnesses
+		}
+
+		if witnessTypeBucket.Get(witnessKey) == nil {
+			return ErrNoWitnesses
+		}
+
+		// Witnesses added before the add index existed won't have any
+		// state, so we'll start from an empty one.
+		state := fetchWitnessState(witnessTypeBucket, witnessKey)
+		if state == nil {
+			state = &witnessState{}
+		}
+
+		if state.expiryHeight >= expiryHeight {
+			return nil
+		}
+
+		return updateWitnessExpiry(
+			witnessTypeBucket, witnessKey, state, expiryHeight,
+		)
+	})
+}
+
+// PruneWitnesses removes all witnesses of wType whose expiry height, as set
+// by SetWitnessExpiry, is below beforeHeight. If MaxEntries is set, then the
+// oldest witnesses which have been marked to expire will also be removed
+// until at most MaxEntries remain, or no such witnesses are left. The entries
+// of each removed witness within the add and expiry indexes are removed along
+// with it. The number of witnesses removed from the cache is returned.
+func (w *WitnessCache) PruneWitnesses(wType WitnessType,
+	beforeHeight uint32) (uint64, error) {
+
+	var numPruned uint64
+	err := w.db.Batch(func(tx *bolt.Tx) error {
+		// As this closure may be retried, we'll reset the count
+		// each time it's executed.
+		numPruned = 0
+
+		witnessBucket := tx.Bucket(witnessBucketKey)
+		if witnessBucket == nil {
+			return nil
+		}
+
+		witnessTypeBucketKey, err := wType.toDBKey()
+		if err != nil {
+			return err
+		}
+		witnessTypeBucket := witnessBucket.Bucket(witnessTypeBucketKey)
+		if witnessTypeBucket == nil {
+			return nil
+		}
+
+		// We'll start by removing all witnesses that have expired.
+		// Since the expiry index is sorted by height, we can stop at
+		// the first entry at or above the target height. As a bucket
+		// can't be modified while iterating over it, we'll collect
+		// the expired entries first.
+		expiryIndex := witnessTypeBucket.Bucket(
+			witnessExpiryIndexBucketKey,
+		)
+		if expiryIndex != nil {
+			var expiryKeys [][]byte
+			c := expiryIndex.Cursor()
+			for k, _ := c.First(); k != nil; k, _ = c.Next() {
+				if byteOrder.Uint32(k[:4]) >= beforeHeight {
+					break
+				}
+
+				expiryKeys = append(
+					expiryKeys, append([]byte(nil), k...),
+				)
+			}
+
+			for _, k := range expiryKeys {
+				witnessKey := k[4:]
+				if witnessTypeBucket.Get(witnessKey) != nil {
+					err := removeWitness(
+						witnessTypeBucket, witnessKey,
+					)
+					if err != nil {
+						return err
+					}
+					numPruned++
+				}
+
+				// The entry will usually have been removed
+				// along with the witness, but we'll remove it
+				// directly in case the witness is missing.
+				if err := expiryIndex.Delete(k); err != nil {
+					return err
+				}
+			}
+		}
+
+		addIndex := witnessTypeBucket.Bucket(witnessAddIndexBucketKey)
+		if w.MaxEntries == 0 || addIndex == nil {
+			return nil
+		}
+
+		// Otherwise, we'll count the witnesses that remain. Any
+		// sub-buckets will have a nil value, so they aren't counted.
+		var numLive uint64
+		err = witnessTypeBucket.ForEach(func(_, v []byte) error {
+			if v != nil {
+				numLive++
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// If we have more witnesses than permitted, then we'll walk
+		// the add index from oldest to newest, collecting the
+		// witnesses marked to expire until we're within the limit.
+		var evictedKeys [][]byte
+		c := addIndex.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if numLive <= w.MaxEntries {
+				break
+			}
+
+			state := fetchWitnessState(witnessTypeBucket, v)
+			if state == nil || state.expiryHeight == 0 {
+				continue
+			}
+
+			witnessKey := append([]byte(nil), v...)
+			evictedKeys = append(evictedKeys, witnessKey)
+			numLive--
+		}
+
+		for _, witnessKey := range evictedKeys {
+			err := removeWitness(witnessTypeBucket, witnessKey)
+			if err != nil {
+				return err
+			}
+			numPruned++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numPruned, nil
+}
+
 // DeleteWitnessClass attempts to delete an *entire* class of witnesses. After
 // this function return with a non-nil error,
 func (w *WitnessCache) DeleteWitnessClass(wType WitnessType) error {
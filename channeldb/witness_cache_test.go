@@ -4,8 +4,46 @@ import (
 	"crypto/sha256"
 	"reflect"
 	"testing"
+
+	"github.com/coreos/bbolt"
 )
 
+// assertWitnessIndexSize asserts that the index of the sha256 hash witness
+// type stored under indexKey contains the expected number of entries.
+func assertWitnessIndexSize(t *testing.T, cdb *DB, indexKey []byte,
+	expected int) {
+
+	t.Helper()
+
+	var numEntries int
+	err := cdb.View(func(tx *bolt.Tx) error {
+		witnessTypeKey, err := Sha256HashWitness.toDBKey()
+		if err != nil {
+			return err
+		}
+
+		index := tx.Bucket(witnessBucketKey).Bucket(
+			witnessTypeKey,
+		).Bucket(indexKey)
+		if index == nil {
+			return nil
+		}
+
+		return index.ForEach(func(_, _ []byte) error {
+			numEntries++
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("unable to read index %s: %v", indexKey, err)
+	}
+
+	if numEntries != expected {
+		t.Fatalf("expected %v entries in index %s, got %v", expected,
+			indexKey, numEntries)
+	}
+}
+
 // TestWitnessCacheRetrieval tests that we're able to add and lookup new
 // witnesses to the witness cache.
 func TestWitnessCacheRetrieval(t *testing.T) {
@@ -112,3 +150,344 @@ func TestWitnessCacheUnknownWitness(t *testing.T) {
 		t.Fatalf("expected ErrUnknownWitnessType, got %v", err)
 	}
 }
+
+// TestWitnessCacheAddedSince tests that witnesses are returned in the order
+// they were added when querying for all witnesses added after a checkpoint.
+func TestWitnessCacheAddedSince(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	wCache := cdb.NewWitnessCache()
+
+	// Before any witnesses have been added, we should get back an empty
+	// set along with the passed checkpoint.
+	witnesses, addIndex, err := wCache.WitnessesAddedSince(
+		Sha256HashWitness, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to query witnesses: %v", err)
+	}
+	if len(witnesses) != 0 || addIndex != 0 {
+		t.Fatalf("expected no witnesses, got %v with add index %v",
+			len(witnesses), addIndex)
+	}
+
+	// We'll now add three witnesses. The first witness is added twice to
+	// ensure that duplicate additions don't bump the add index.
+	witness1, witness2, witness3 := rev[:], key[:], id.Hash[:]
+	for _, witness := range [][]byte{witness1, witness1, witness2, witness3} {
+		if err := wCache.AddWitness(Sha256HashWitness, witness); err != nil {
+			t.Fatalf("unable to add witness: %v", err)
+		}
+	}
+
+	testCases := []struct {
+		since             uint64
+		expectedWitnesses [][]byte
+	}{
+		{
+			since:             0,
+			expectedWitnesses: [][]byte{witness1, witness2, witness3},
+		},
+		{
+			since:             1,
+			expectedWitnesses: [][]byte{witness2, witness3},
+		},
+		{
+			since:             3,
+			expectedWitnesses: nil,
+		},
+	}
+	for i, testCase := range testCases {
+		witnesses, addIndex, err := wCache.WitnessesAddedSince(
+			Sha256HashWitness, testCase.since,
+		)
+		if err != nil {
+			t.Fatalf("test #%d: unable to query witnesses: %v",
+				i, err)
+		}
+		if addIndex != 3 {
+			t.Fatalf("test #%d: expected add index 3, got %v", i,
+				addIndex)
+		}
+		if !reflect.DeepEqual(witnesses, testCase.expectedWitnesses) {
+			t.Fatalf("test #%d: witnesses don't match: expected "+
+				"%x, got %x", i, testCase.expectedWitnesses,
+				witnesses)
+		}
+	}
+
+	// Finally, if we delete the second witness, it should be skipped by
+	// subsequent queries.
+	witness2Key := sha256.Sum256(witness2)
+	err = wCache.DeleteWitness(Sha256HashWitness, witness2Key[:])
+	if err != nil {
+		t.Fatalf("unable to delete witness: %v", err)
+	}
+	witnesses, _, err = wCache.WitnessesAddedSince(Sha256HashWitness, 0)
+	if err != nil {
+		t.Fatalf("unable to query witnesses: %v", err)
+	}
+	expected := [][]byte{witness1, witness3}
+	if !reflect.DeepEqual(witnesses, expected) {
+		t.Fatalf("witnesses don't match: expected %x, got %x",
+			expected, witnesses)
+	}
+}
+
+// TestWitnessCachePrune tests that expired witnesses, and the oldest witnesses
+// marked to expire in excess of the max number of entries, are pruned from the
+// cache.
+func TestWitnessCachePrune(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	wCache := cdb.NewWitnessCache()
+
+	// Pruning an empty cache should be a no-op.
+	numPruned, err := wCache.PruneWitnesses(Sha256HashWitness, 100)
+	if err != nil {
+		t.Fatalf("unable to prune witnesses: %v", err)
+	}
+	if numPruned != 0 {
+		t.Fatalf("expected no witnesses to be pruned, got %v",
+			numPruned)
+	}
+
+	witnesses := [][]byte{rev[:], key[:], id.Hash[:]}
+	witnessKeys := make([][32]byte, len(witnesses))
+	for i, witness := range witnesses {
+		err := wCache.AddWitness(Sha256HashWitness, witness)
+		if err != nil {
+			t.Fatalf("unable to add witness: %v", err)
+		}
+		witnessKeys[i] = sha256.Sum256(witness)
+	}
+
+	// Setting the expiry of an unknown witness should fail.
+	unknownKey := sha256.Sum256([]byte("unknown"))
+	err = wCache.SetWitnessExpiry(Sha256HashWitness, unknownKey[:], 10)
+	if err != ErrNoWitnesses {
+		t.Fatalf("expected ErrNoWitnesses, got: %v", err)
+	}
+
+	// We'll mark the second witness to expire at height 10.
+	err = wCache.SetWitnessExpiry(Sha256HashWitness, witnessKeys[1][:], 10)
+	if err != nil {
+		t.Fatalf("unable to set witness expiry: %v", err)
+	}
+
+	assertPruned := func(beforeHeight uint32, expected uint64) {
+		t.Helper()
+
+		numPruned, err := wCache.PruneWitnesses(
+			Sha256HashWitness, beforeHeight,
+		)
+		if err != nil {
+			t.Fatalf("unable to prune witnesses: %v", err)
+		}
+		if numPruned != expected {
+			t.Fatalf("expected %v witnesses to be pruned, got %v",
+				expected, numPruned)
+		}
+	}
+	assertExists := func(i int, exists bool) {
+		t.Helper()
+
+		_, err := wCache.LookupWitness(
+			Sha256HashWitness, witnessKeys[i][:],
+		)
+		switch {
+		case exists && err != nil:
+			t.Fatalf("unable to look up witness %v: %v", i, err)
+		case !exists && err != ErrNoWitnesses:
+			t.Fatalf("expected ErrNoWitnesses for witness %v, "+
+				"got: %v", i, err)
+		}
+	}
+
+	// Pruning at the expiry height shouldn't remove anything.
+	assertPruned(10, 0)
+	assertExists(1, true)
+
+	// Once we're beyond the expiry height, the witness should be removed
+	// while the others remain. Its entries within the add and expiry
+	// indexes should be removed along with it.
+	assertPruned(11, 1)
+	assertExists(0, true)
+	assertExists(1, false)
+	assertExists(2, true)
+	assertWitnessIndexSize(t, cdb, witnessAddIndexBucketKey, 2)
+	assertWitnessIndexSize(t, cdb, witnessExpiryIndexBucketKey, 0)
+	assertWitnessIndexSize(t, cdb, witnessStateBucketKey, 2)
+
+	// Next, we'll bound the cache to a single entry. As neither of the
+	// remaining witnesses has been marked to expire, neither of them may
+	// be evicted.
+	wCache.MaxEntries = 1
+	assertPruned(11, 0)
+	assertExists(0, true)
+	assertExists(2, true)
+
+	// Once the most recent witness is marked to expire, it should be
+	// evicted even though its expiry height hasn't yet been reached.
+	err = wCache.SetWitnessExpiry(Sha256HashWitness, witnessKeys[2][:], 100)
+	if err != nil {
+		t.Fatalf("unable to set witness expiry: %v", err)
+	}
+	assertPruned(11, 1)
+	assertExists(0, true)
+	assertExists(2, false)
+	assertWitnessIndexSize(t, cdb, witnessAddIndexBucketKey, 1)
+	assertWitnessIndexSize(t, cdb, witnessExpiryIndexBucketKey, 0)
+	assertWitnessIndexSize(t, cdb, witnessStateBucketKey, 1)
+
+	// The witness should no longer be replayed from the add index.
+	added, _, err := wCache.WitnessesAddedSince(Sha256HashWitness, 0)
+	if err != nil {
+		t.Fatalf("unable to fetch added witnesses: %v", err)
+	}
+	if len(added) != 1 || !reflect.DeepEqual(added[0], witnesses[0]) {
+		t.Fatalf("expected only witness %x, got %x", witnesses[0],
+			added)
+	}
+}
+
+// TestWitnessCacheExpiry tests that setting the expiry of a witness more than
+// once retains the latest expiry height, and that adding a witness again
+// clears its expiry.
+func TestWitnessCacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	wCache := cdb.NewWitnessCache()
+
+	witness := rev[:]
+	witnessKey := sha256.Sum256(witness)
+	if err := wCache.AddWitness(Sha256HashWitness, witness); err != nil {
+		t.Fatalf("unable to add witness: %v", err)
+	}
+
+	setExpiry := func(expiryHeight uint32) {
+		t.Helper()
+
+		err := wCache.SetWitnessExpiry(
+			Sha256HashWitness, witnessKey[:], expiryHeight,
+		)
+		if err != nil {
+			t.Fatalf("unable to set witness expiry: %v", err)
+		}
+	}
+	assertPruned := func(beforeHeight uint32, expected uint64) {
+		t.Helper()
+
+		numPruned, err := wCache.PruneWitnesses(
+			Sha256HashWitness, beforeHeight,
+		)
+		if err != nil {
+			t.Fatalf("unable to prune witnesses: %v", err)
+		}
+		if numPruned != expected {
+			t.Fatalf("expected %v witnesses to be pruned, got %v",
+				expected, numPruned)
+		}
+	}
+
+	// We'll set the expiry of the witness several times. Only a single
+	// entry should be kept within the expiry index, for the latest height.
+	setExpiry(10)
+	setExpiry(20)
+	setExpiry(15)
+	assertWitnessIndexSize(t, cdb, witnessExpiryIndexBucketKey, 1)
+
+	assertPruned(20, 0)
+
+	// If the witness is added again, then its expiry should be cleared,
+	// so it isn't pruned once the prior expiry height has passed.
+	if err := wCache.AddWitness(Sha256HashWitness, witness); err != nil {
+		t.Fatalf("unable to add witness: %v", err)
+	}
+	assertWitnessIndexSize(t, cdb, witnessExpiryIndexBucketKey, 0)
+
+	assertPruned(100, 0)
+	_, err = wCache.LookupWitness(Sha256HashWitness, witnessKey[:])
+	if err != nil {
+		t.Fatalf("unable to look up witness: %v", err)
+	}
+
+	// Once the witness is marked to expire again, it should be pruned
+	// along with all of its index entries.
+	setExpiry(30)
+	assertPruned(31, 1)
+	assertWitnessIndexSize(t, cdb, witnessAddIndexBucketKey, 0)
+	assertWitnessIndexSize(t, cdb, witnessExpiryIndexBucketKey, 0)
+	assertWitnessIndexSize(t, cdb, witnessStateBucketKey, 0)
+}
+
+// TestWitnessCacheBatch tests that we're able to add and lookup a batch of
+// witnesses within the witness cache.
+func TestWitnessCacheBatch(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	wCache := cdb.NewWitnessCache()
+
+	// We'll add a batch of witnesses, including a duplicate which should
+	// only be recorded once.
+	witnesses := [][]byte{rev[:], key[:], rev[:]}
+	err = wCache.AddWitnesses(Sha256HashWitness, witnesses...)
+	if err != nil {
+		t.Fatalf("unable to add witnesses: %v", err)
+	}
+
+	added, lastAddIndex, err := wCache.WitnessesAddedSince(
+		Sha256HashWitness, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch added witnesses: %v", err)
+	}
+	if lastAddIndex != 2 {
+		t.Fatalf("expected last add index of 2, got %v", lastAddIndex)
+	}
+	if !reflect.DeepEqual(added, witnesses[:2]) {
+		t.Fatalf("expected witnesses %x, got %x", witnesses[:2], added)
+	}
+
+	// We'll now look up both witnesses along with one that was never
+	// added. The unknown witness should be returned as a nil entry.
+	key1 := sha256.Sum256(witnesses[0])
+	key2 := sha256.Sum256(witnesses[1])
+	unknownKey := sha256.Sum256(id.Hash[:])
+	dbWitnesses, err := wCache.LookupWitnesses(
+		Sha256HashWitness, [][]byte{key1[:], unknownKey[:], key2[:]},
+	)
+	if err != nil {
+		t.Fatalf("unable to look up witnesses: %v", err)
+	}
+
+	expected := [][]byte{witnesses[0], nil, witnesses[1]}
+	if !reflect.DeepEqual(dbWitnesses, expected) {
+		t.Fatalf("witnesses don't match: expected %x, got %x",
+			expected, dbWitnesses)
+	}
+}
@@ -2531,14 +2531,76 @@ var listPaymentsCommand = cli.Command{
 	Name:     "listpayments",
 	Category: "Payments",
 	Usage:    "List all outgoing payments.",
-	Action:   actionDecorator(listPayments),
+	Description: `
+	This command enables the retrieval of outgoing payments stored within
+	the database. It has support for paginated responses, allowing users to
+	query for specific payments through their payment index. This can be
+	done by using either the first_index_offset or last_index_offset fields
+	included in the response as the index_offset of the next request. If
+	none of the parameters are specified, then all payments will be
+	returned.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "index_offset",
+			Usage: "the index of a payment that will be used as " +
+				"either the start or end of a query to " +
+				"determine which payments should be returned " +
+				"in the response",
+		},
+		cli.Uint64Flag{
+			Name:  "max_payments",
+			Usage: "the max number of payments to return",
+		},
+		cli.BoolFlag{
+			Name: "reversed",
+			Usage: "if set, the payments returned precede the " +
+				"given index_offset, allowing backwards " +
+				"pagination",
+		},
+		cli.Int64Flag{
+			Name: "start_time",
+			Usage: "if set, payments created before this unix " +
+				"timestamp are excluded",
+		},
+		cli.Int64Flag{
+			Name: "end_time",
+			Usage: "if set, payments created after this unix " +
+				"timestamp are excluded",
+		},
+		cli.StringSliceFlag{
+			Name: "status",
+			Usage: "if set, only payments with this status are " +
+				"returned, one of grounded, in_flight or " +
+				"completed; can be specified multiple times",
+		},
+	},
+	Action: actionDecorator(listPayments),
 }
 
 func listPayments(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.ListPaymentsRequest{}
+	req := &lnrpc.ListPaymentsRequest{
+		IndexOffset:    ctx.Uint64("index_offset"),
+		NumMaxPayments: ctx.Uint64("max_payments"),
+		Reversed:       ctx.Bool("reversed"),
+		StartTime:      ctx.Int64("start_time"),
+		EndTime:        ctx.Int64("end_time"),
+	}
+
+	statusValues := lnrpc.ListPaymentsRequest_PaymentStatus_value
+	for _, s := range ctx.StringSlice("status") {
+		status, ok := statusValues[strings.ToUpper(s)]
+		if !ok {
+			return fmt.Errorf("unknown payment status: %v", s)
+		}
+
+		req.Statuses = append(
+			req.Statuses,
+			lnrpc.ListPaymentsRequest_PaymentStatus(status),
+		)
+	}
 
 	payments, err := client.ListPayments(context.Background(), req)
 	if err != nil {
@@ -2549,6 +2611,111 @@ func listPayments(ctx *cli.Context) error {
 	return nil
 }
 
+var deletePaymentCommand = cli.Command{
+	Name:      "deletepayment",
+	Category:  "Payments",
+	Usage:     "Delete an outgoing payment by its payment hash.",
+	ArgsUsage: "payment_hash",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "payment_hash",
+			Usage: "the 32 byte payment hash of the payment to delete, " +
+				"the hash should be a hex-encoded string",
+		},
+	},
+	Action: actionDecorator(deletePayment),
+}
+
+func deletePayment(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		payHash []byte
+		err     error
+	)
+
+	switch {
+	case ctx.IsSet("payment_hash"):
+		payHash, err = hex.DecodeString(ctx.String("payment_hash"))
+	case ctx.Args().Present():
+		payHash, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("payment_hash argument missing")
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to decode payment_hash argument: %v",
+			err)
+	}
+
+	req := &lnrpc.DeletePaymentRequest{
+		PaymentHash: payHash,
+	}
+
+	resp, err := client.DeletePayment(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var deletePaymentsCommand = cli.Command{
+	Name:     "deletepayments",
+	Category: "Payments",
+	Usage:    "Delete all outgoing payments created before a given time.",
+	Description: `
+	Delete all outgoing payments that were created before the passed unix
+	timestamp. The number of deleted payments is returned.
+	`,
+	ArgsUsage: "created_before",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "created_before",
+			Usage: "the unix timestamp before which all payments " +
+				"will be deleted",
+		},
+	},
+	Action: actionDecorator(deletePayments),
+}
+
+func deletePayments(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		createdBefore int64
+		err           error
+	)
+
+	switch {
+	case ctx.IsSet("created_before"):
+		createdBefore = ctx.Int64("created_before")
+	case ctx.Args().Present():
+		createdBefore, err = strconv.ParseInt(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode created_before: %v",
+				err)
+		}
+	default:
+		return fmt.Errorf("created_before argument missing")
+	}
+
+	req := &lnrpc.DeletePaymentsBeforeRequest{
+		CreatedBefore: createdBefore,
+	}
+
+	resp, err := client.DeletePaymentsBefore(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var getChanInfoCommand = cli.Command{
 	Name:     "getchaninfo",
 	Category: "Channels",
@@ -276,6 +276,8 @@ func main() {
 		listChannelsCommand,
 		closedChannelsCommand,
 		listPaymentsCommand,
+		deletePaymentCommand,
+		deletePaymentsCommand,
 		describeGraphCommand,
 		getChanInfoCommand,
 		getNodeInfoCommand,
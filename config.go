@@ -237,6 +237,8 @@ type config struct {
 
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	WitnessCacheMaxEntries uint64 `long:"witnesscachemaxentries" description:"The maximum number of preimages to retain within the witness cache when it is pruned at each new block. Preimages are marked to expire once the HTLC they settle has been resolved, and are pruned 144 blocks after it was resolved on-chain, or after it expires if settled off-chain. Only preimages marked to expire may be evicted to satisfy this limit, the oldest first. A value of 0 means only expired preimages are pruned."`
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -215,6 +215,11 @@ func assertResolversEqual(t *testing.T, originalResolver ContractResolver,
 			t.Fatalf("expected %v, got %v", ogRes.htlcExpiry,
 				diskRes.htlcExpiry)
 		}
+		if ogRes.preimageAddIndex != diskRes.preimageAddIndex {
+			t.Fatalf("expected %v, got %v",
+				ogRes.preimageAddIndex,
+				diskRes.preimageAddIndex)
+		}
 
 	case *commitSweepResolver:
 		diskRes := diskResolver.(*commitSweepResolver)
@@ -309,6 +314,7 @@ func TestContractInsertionRetrieval(t *testing.T) {
 	contestSuccess.htlcResolution.ClaimOutpoint = randOutPoint()
 	resolvers = append(resolvers, &htlcIncomingContestResolver{
 		htlcExpiry:          100,
+		preimageAddIndex:    5,
 		htlcSuccessResolver: contestSuccess,
 	})
 
@@ -32,6 +32,16 @@ type WitnessSubscription struct {
 	// TODO(roasbeef): couple with WitnessType?
 	WitnessUpdates <-chan []byte
 
+	// AddIndex is the add index of the most recent witness known at the
+	// time the subscription was created. All witnesses added after this
+	// index will be delivered over WitnessUpdates, so this value can be
+	// persisted by the client and used as the checkpoint when
+	// resubscribing after a restart.
+	//
+	// NOTE: This is only populated for subscriptions created via
+	// SubscribeUpdatesForHashSince.
+	AddIndex uint64
+
 	// CancelSubscription is a function closure that should be used by a
 	// client to cancel the subscription once they are no longer interested
 	// in receiving new updates.
@@ -41,21 +51,48 @@ type WitnessSubscription struct {
 // WitnessBeacon is a global beacon of witnesses. Contract resolvers will use
 // this interface to lookup witnesses (preimages typically) of contracts
 // they're trying to resolve, add new preimages they resolve, and finally
-// receive new updates each new time a preimage is discovered.
-//
-// TODO(roasbeef): need to delete the pre-images once we've used them
-// and have been sufficiently confirmed?
+// receive new updates each new time a preimage is discovered. Once a contract
+// has been fully resolved, resolvers will mark the preimage used to claim it
+// to expire, allowing it to be pruned from the cache.
 type WitnessBeacon interface {
 	// SubscribeUpdates returns a channel that will be sent upon *each* time
 	// a new preimage is discovered.
 	SubscribeUpdates() *WitnessSubscription
 
+	// SubscribeUpdatesForHashSince returns a channel that will be sent
+	// upon only when a new preimage matching the target payment hash is
+	// discovered. If a matching preimage was discovered after the
+	// specified add index, then it will be replayed before any new
+	// preimages are sent.
+	SubscribeUpdatesForHashSince(payHash [32]byte,
+		addIndex uint64) (*WitnessSubscription, error)
+
 	// LookupPreImage attempts to lookup a preimage in the global cache.
 	// True is returned for the second argument if the preimage is found.
 	LookupPreimage(payhash []byte) ([]byte, bool)
 
+	// LookupPreimages attempts to lookup a batch of preimages in the
+	// global cache. The returned slice is index-aligned with the passed
+	// payment hashes, and contains a nil entry for each preimage that
+	// isn't found. This should be preferred over LookupPreimage when
+	// several preimages are needed at once, as those within the witness
+	// cache will be read within a single database transaction.
+	LookupPreimages(payHashes ...[]byte) ([][]byte, error)
+
 	// AddPreImage adds a newly discovered preimage to the global cache.
 	AddPreimage(pre []byte) error
+
+	// AddPreimages adds a batch of newly discovered preimages to the
+	// global cache. This should be preferred over AddPreimage when
+	// several preimages are discovered at once, as they'll be written
+	// within a single database transaction.
+	AddPreimages(preimages ...[]byte) error
+
+	// SetPreimageExpiry marks the preimage matching the target payment
+	// hash to be pruned from the global cache once the chain has advanced
+	// beyond expiryHeight. This should be called once the contract the
+	// preimage was used to claim has been fully resolved.
+	SetPreimageExpiry(payHash [32]byte, expiryHeight uint32) error
 }
 
 // ChannelArbitratorConfig contains all the functionality that the
@@ -884,6 +921,49 @@ func (c *ChannelArbitrator) shouldGoOnChain(htlcExpiry, broadcastDelta,
 	return currentHeight >= broadcastCutOff
 }
 
+// knownIncomingPreimages returns the set of payment hashes of our incoming
+// HTLCs for which we know the preimage. The preimages are looked up as a
+// single batch, falling back to a lookup per HTLC if the batch lookup fails.
+func (c *ChannelArbitrator) knownIncomingPreimages() map[[32]byte]struct{} {
+	knownPreimages := make(map[[32]byte]struct{})
+	if len(c.activeHTLCs.incomingHTLCs) == 0 {
+		return knownPreimages
+	}
+
+	payHashes := make([][32]byte, 0, len(c.activeHTLCs.incomingHTLCs))
+	for _, htlc := range c.activeHTLCs.incomingHTLCs {
+		payHashes = append(payHashes, htlc.RHash)
+	}
+
+	hashKeys := make([][]byte, len(payHashes))
+	for i := range payHashes {
+		hashKeys[i] = payHashes[i][:]
+	}
+
+	preimages, err := c.cfg.PreimageDB.LookupPreimages(hashKeys...)
+	if err != nil {
+		log.Errorf("ChannelArbitrator(%v): unable to look up "+
+			"preimages: %v", c.cfg.ChanPoint, err)
+
+		for _, payHash := range payHashes {
+			_, ok := c.cfg.PreimageDB.LookupPreimage(payHash[:])
+			if ok {
+				knownPreimages[payHash] = struct{}{}
+			}
+		}
+
+		return knownPreimages
+	}
+
+	for i, preimage := range preimages {
+		if preimage != nil {
+			knownPreimages[payHashes[i]] = struct{}{}
+		}
+	}
+
+	return knownPreimages
+}
+
 // checkChainActions is called for each new block connected to the end of the
 // main chain. Given the new block height, this new method will examine all
 // active HTLC's, and determine if we need to go on-chain to claim any of them.
@@ -904,6 +984,10 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 	actionMap := make(ChainActionMap)
 	redeemCutoff := c.cfg.BroadcastDelta * broadcastRedeemMultiplier
 
+	// We'll look up the preimages of all our incoming HTLCs at once, as
+	// we'll consult them in both passes below.
+	knownPreimages := c.knownIncomingPreimages()
+
 	// First, we'll make an initial pass over the set of incoming and
 	// outgoing HTLC's to decide if we need to go on chain at all.
 	haveChainActions := false
@@ -931,7 +1015,7 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 		// know the pre-image and it's close to timing out. We need to
 		// ensure that we claim the funds that our rightfully ours
 		// on-chain.
-		if _, ok := c.cfg.PreimageDB.LookupPreimage(htlc.RHash[:]); !ok {
+		if _, ok := knownPreimages[htlc.RHash]; !ok {
 			continue
 		}
 		haveChainActions = haveChainActions || c.shouldGoOnChain(
@@ -1012,7 +1096,7 @@ func (c *ChannelArbitrator) checkChainActions(height uint32,
 
 		// If we have the pre-image, then we should go on-chain to
 		// redeem the HTLC immediately.
-		if _, ok := c.cfg.PreimageDB.LookupPreimage(payHash[:]); ok {
+		if _, ok := knownPreimages[payHash]; ok {
 			log.Tracef("ChannelArbitrator(%v): preimage for "+
 				"htlc=%x is known!", c.cfg.ChanPoint, payHash[:])
 
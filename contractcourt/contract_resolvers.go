@@ -25,6 +25,12 @@ const (
 	// sweepConfTarget is the default number of blocks that we'll use as a
 	// confirmation target when sweeping.
 	sweepConfTarget = 6
+
+	// PreimageExpiryDelta is the number of blocks after an incoming HTLC
+	// has been resolved that we'll retain the preimage used to claim it
+	// within the witness cache. This ensures the preimage is still
+	// available should the resolution be reorged out.
+	PreimageExpiryDelta = 144
 )
 
 // ContractResolver is an interface which packages a state machine which is
@@ -514,11 +520,13 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 		log.Infof("%T(%x): waiting for sweep tx (txid=%v) to be "+
 			"confirmed", h, h.payHash[:], sweepTXID)
 
+		var sweepHeight uint32
 		select {
-		case _, ok := <-confNtfn.Confirmed:
+		case conf, ok := <-confNtfn.Confirmed:
 			if !ok {
 				return nil, fmt.Errorf("quitting")
 			}
+			sweepHeight = conf.BlockHeight
 
 		case <-h.Quit:
 			return nil, fmt.Errorf("quitting")
@@ -526,8 +534,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 
 		// Once the transaction has received a sufficient number of
 		// confirmations, we'll mark ourselves as fully resolved and exit.
-		h.resolved = true
-		return nil, h.Checkpoint(h)
+		return nil, h.markResolved(sweepHeight)
 	}
 
 	log.Infof("%T(%x): broadcasting second-layer transition tx: %v",
@@ -579,18 +586,38 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	log.Infof("%T(%x): waiting for second-level HTLC output to be spent "+
 		"after csv_delay=%v", h, h.payHash[:], h.htlcResolution.CsvDelay)
 
+	var spendHeight uint32
 	select {
-	case _, ok := <-spendNtfn.Spend:
+	case spend, ok := <-spendNtfn.Spend:
 		if !ok {
 			return nil, fmt.Errorf("quitting")
 		}
+		spendHeight = uint32(spend.SpendingHeight)
 
 	case <-h.Quit:
 		return nil, fmt.Errorf("quitting")
 	}
 
+	return nil, h.markResolved(spendHeight)
+}
+
+// markResolved marks the resolver as fully resolved at the given height, and
+// marks the preimage used to claim the HTLC to expire from the witness cache
+// once it's no longer needed.
+func (h *htlcSuccessResolver) markResolved(resolveHeight uint32) error {
 	h.resolved = true
-	return nil, h.Checkpoint(h)
+	if err := h.Checkpoint(h); err != nil {
+		return err
+	}
+
+	expiryHeight := resolveHeight + PreimageExpiryDelta
+	err := h.PreimageDB.SetPreimageExpiry(h.payHash, expiryHeight)
+	if err != nil {
+		log.Errorf("%T(%x): unable to set preimage expiry: %v", h,
+			h.payHash[:], err)
+	}
+
+	return nil
 }
 
 // Stop signals the resolver to cancel any current resolution processes, and
@@ -689,6 +716,75 @@ type htlcOutgoingContestResolver struct {
 	htlcTimeoutResolver
 }
 
+// extractSpendPreimages returns the preimages revealed within the witnesses of
+// the inputs of the spending transaction that share the witness shape of the
+// input at spenderIndex, which swept one of our outgoing HTLCs. The preimage of
+// the input at spenderIndex is always returned first. The preimage of any
+// other input is only returned if its witness script commits to its hash, so
+// that unrelated witness elements aren't mistaken for preimages.
+func extractSpendPreimages(spendingTx *wire.MsgTx, spenderIndex uint32,
+	preimageIndex int) [][]byte {
+
+	spenderWitness := spendingTx.TxIn[spenderIndex].Witness
+	preimages := [][]byte{spenderWitness[preimageIndex]}
+
+	for i, txIn := range spendingTx.TxIn {
+		if uint32(i) == spenderIndex {
+			continue
+		}
+
+		witness := txIn.Witness
+		if len(witness) != len(spenderWitness) {
+			continue
+		}
+
+		candidate := witness[preimageIndex]
+		if len(candidate) != sha256.Size {
+			continue
+		}
+
+		payHash := sha256.Sum256(candidate)
+		hashLock := lnwallet.Ripemd160H(payHash[:])
+		if !bytes.Contains(witness[len(witness)-1], hashLock) {
+			continue
+		}
+
+		preimages = append(preimages, candidate)
+	}
+
+	return preimages
+}
+
+// addUnknownPreimages adds those of the passed preimages that aren't yet known
+// to the global cache, within a single batch.
+func (h *htlcOutgoingContestResolver) addUnknownPreimages(
+	preimages [][]byte) error {
+
+	payHashes := make([][]byte, len(preimages))
+	for i, preimage := range preimages {
+		payHash := sha256.Sum256(preimage)
+		payHashes[i] = payHash[:]
+	}
+
+	knownPreimages, err := h.PreimageDB.LookupPreimages(payHashes...)
+	if err != nil {
+		return err
+	}
+
+	var newPreimages [][]byte
+	for i, preimage := range preimages {
+		if knownPreimages[i] == nil {
+			newPreimages = append(newPreimages, preimage)
+		}
+	}
+
+	if len(newPreimages) == 0 {
+		return nil
+	}
+
+	return h.PreimageDB.AddPreimages(newPreimages...)
+}
+
 // Resolve commences the resolution of this contract. As this contract hasn't
 // yet timed out, we'll wait for one of two things to happen
 //
@@ -725,30 +821,41 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 		// If this is the remote party's commitment, then we'll be
 		// looking for them to spend using the second-level success
 		// transaction.
-		var preimage [32]byte
+		var (
+			preimage      [32]byte
+			preimageIndex int
+		)
 		if h.htlcResolution.SignedTimeoutTx == nil {
 			// The witness stack when the remote party sweeps the
 			// output to them looks like:
 			//
 			//  * <sender sig> <recvr sig> <preimage> <witness script>
-			copy(preimage[:], spendingInput.Witness[3])
+			preimageIndex = 3
 		} else {
 			// Otherwise, they'll be spending directly from our
 			// commitment output. In which case the witness stack
 			// looks like:
 			//
 			//  * <sig> <preimage> <witness script>
-			copy(preimage[:], spendingInput.Witness[1])
+			preimageIndex = 1
 		}
+		copy(preimage[:], spendingInput.Witness[preimageIndex])
 
 		log.Infof("%T(%v): extracting preimage=%x from on-chain "+
 			"spend!", h, h.htlcResolution.ClaimOutpoint, preimage[:])
 
-		// With the preimage obtained, we can now add it to the global
-		// cache.
-		if err := h.PreimageDB.AddPreimage(preimage[:]); err != nil {
-			log.Errorf("%T(%v): unable to add witness to cache",
-				h, h.htlcResolution.ClaimOutpoint)
+		// The remote party may have swept several HTLCs within the
+		// same transaction, so we'll extract the preimages revealed by
+		// all of them, and add any we don't yet know of to the global
+		// cache at once. Those already known are skipped, so we don't
+		// clear the expiry of preimages of HTLCs already resolved.
+		preimages := extractSpendPreimages(
+			commitSpend.SpendingTx, spenderIndex, preimageIndex,
+		)
+		err := h.addUnknownPreimages(preimages)
+		if err != nil {
+			log.Errorf("%T(%v): unable to add witnesses to cache: "+
+				"%v", h, h.htlcResolution.ClaimOutpoint, err)
 		}
 
 		// Finally, we'll send the clean up message, mark ourselves as
@@ -962,6 +1069,13 @@ type htlcIncomingContestResolver struct {
 	// successfully.
 	htlcExpiry uint32
 
+	// preimageAddIndex is the add index of the most recent preimage known
+	// to the preimage beacon when we last subscribed for the preimage of
+	// this HTLC. Upon restart, we'll resubscribe from this checkpoint,
+	// ensuring that we don't miss the preimage if it was discovered while
+	// we were offline.
+	preimageAddIndex uint64
+
 	// htlcSuccessResolver is the inner resolver that may be utilized if we
 	// learn of the preimage.
 	htlcSuccessResolver
@@ -1041,16 +1155,32 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 	//
 	// NOTE: This is done BEFORE opportunistically querying the db, to
 	// ensure the preimage can't be delivered between querying and
-	// registering for the preimage subscription.
-	preimageSubscription := h.PreimageDB.SubscribeUpdates()
+	// registering for the preimage subscription. Any matching preimage
+	// discovered since our last checkpoint will be replayed.
+	preimageSubscription, err := h.PreimageDB.SubscribeUpdatesForHashSince(
+		h.payHash, h.preimageAddIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer preimageSubscription.CancelSubscription()
+
+	// We'll checkpoint the add index of the subscription, so that if we
+	// restart before learning of the preimage, we'll resubscribe from
+	// this point onwards.
+	if preimageSubscription.AddIndex != h.preimageAddIndex {
+		h.preimageAddIndex = preimageSubscription.AddIndex
+		if err := h.Checkpoint(h); err != nil {
+			log.Errorf("unable to Checkpoint: %v", err)
+			return nil, err
+		}
+	}
+
 	blockEpochs, err := h.Notifier.RegisterBlockEpochNtfn(nil)
 	if err != nil {
 		return nil, err
 	}
-	defer func() {
-		preimageSubscription.CancelSubscription()
-		blockEpochs.Cancel()
-	}()
+	defer blockEpochs.Cancel()
 
 	// With the epochs and preimage subscriptions initialized, we'll query
 	// to see if we already know the preimage.
@@ -1133,7 +1263,14 @@ func (h *htlcIncomingContestResolver) Encode(w io.Writer) error {
 	}
 
 	// Then we'll write out our internal resolver.
-	return h.htlcSuccessResolver.Encode(w)
+	if err := h.htlcSuccessResolver.Encode(w); err != nil {
+		return err
+	}
+
+	// Finally, we'll write out the preimage add index checkpoint. This is
+	// written last so that resolvers stored before it was introduced can
+	// still be decoded.
+	return binary.Write(w, endian, h.preimageAddIndex)
 }
 
 // Decode attempts to decode an encoded ContractResolver from the passed Reader
@@ -1147,7 +1284,19 @@ func (h *htlcIncomingContestResolver) Decode(r io.Reader) error {
 	}
 
 	// Then we'll decode our internal resolver.
-	return h.htlcSuccessResolver.Decode(r)
+	if err := h.htlcSuccessResolver.Decode(r); err != nil {
+		return err
+	}
+
+	// Finally, we'll read the preimage add index checkpoint. If it isn't
+	// present, then this resolver was stored before the checkpoint was
+	// introduced, so we'll replay all known preimages.
+	err := binary.Read(r, endian, &h.preimageAddIndex)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
 }
 
 // AttachResolverKit should be called once a resolved is successfully decoded
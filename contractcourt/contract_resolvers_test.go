@@ -1 +1,84 @@
 package contractcourt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestExtractSpendPreimages tests that the preimages of all HTLCs swept within
+// the same transaction are extracted, while unrelated witness elements are
+// ignored.
+func TestExtractSpendPreimages(t *testing.T) {
+	t.Parallel()
+
+	makePreimage := func(b byte) []byte {
+		return bytes.Repeat([]byte{b}, 32)
+	}
+
+	// htlcWitness creates a witness in the shape of a remote party
+	// sweeping an HTLC using the preimage, whose script commits to the
+	// passed payment hash.
+	htlcWitness := func(preimage []byte, payHash [32]byte) wire.TxWitness {
+		witnessScript := append(
+			[]byte{0xa9}, lnwallet.Ripemd160H(payHash[:])...,
+		)
+
+		return wire.TxWitness{
+			nil, []byte("sender sig"), []byte("recvr sig"),
+			preimage, witnessScript,
+		}
+	}
+
+	ourPreimage := makePreimage(1)
+	otherPreimage := makePreimage(2)
+	unrelatedElement := makePreimage(3)
+
+	spendingTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{
+			// The input spending an unrelated output, which should
+			// be ignored as its witness has a different shape.
+			{
+				Witness: wire.TxWitness{
+					[]byte("sig"), makePreimage(4),
+				},
+			},
+
+			// The input spending our HTLC output.
+			{
+				Witness: htlcWitness(
+					ourPreimage, sha256.Sum256(ourPreimage),
+				),
+			},
+
+			// Another HTLC swept within the same transaction,
+			// whose preimage should also be extracted.
+			{
+				Witness: htlcWitness(
+					otherPreimage,
+					sha256.Sum256(otherPreimage),
+				),
+			},
+
+			// An input with the same witness shape, but whose
+			// script doesn't commit to the element's hash.
+			{
+				Witness: htlcWitness(
+					unrelatedElement,
+					sha256.Sum256(otherPreimage),
+				),
+			},
+		},
+	}
+
+	preimages := extractSpendPreimages(spendingTx, 1, 3)
+
+	expected := [][]byte{ourPreimage, otherPreimage}
+	if !reflect.DeepEqual(preimages, expected) {
+		t.Fatalf("expected preimages %x, got %x", expected, preimages)
+	}
+}
@@ -1213,6 +1213,14 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			return
 		}
 
+		// The preimage of this HTLC was added to the preimage cache by
+		// the outgoing link once it was learned. Now that we've settled
+		// the incoming HTLC, the preimage is only needed to claim it
+		// on-chain should we go to chain before the settle is locked
+		// in, so we'll mark it to expire once this is no longer
+		// possible.
+		l.expirePreimage(htlc.PaymentPreimage, pkt.incomingHTLCID)
+
 		l.debugf("Queueing removal of SETTLE closed circuit: %s->%s",
 			pkt.inKey(), pkt.outKey())
 
@@ -1301,6 +1309,36 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 	}
 }
 
+// expirePreimage marks the preimage used to settle the incoming HTLC with the
+// passed index to be pruned from the preimage cache once the HTLC has expired,
+// as the preimage can no longer be used to claim it on-chain beyond this
+// point. The preimage is retained for a further PreimageExpiryDelta blocks,
+// matching the preimages of HTLCs resolved on-chain.
+//
+// NOTE: If the preimage hasn't been added to the cache, then nothing is marked
+// to expire.
+func (l *channelLink) expirePreimage(preimage [32]byte, htlcIndex uint64) {
+	htlcExpiry, err := l.channel.IncomingHtlcExpiry(htlcIndex)
+	if err != nil {
+		l.errorf("unable to fetch expiry of htlc=%v: %v", htlcIndex,
+			err)
+		return
+	}
+
+	payHash := sha256.Sum256(preimage[:])
+	expiryHeight := htlcExpiry + contractcourt.PreimageExpiryDelta
+
+	go func() {
+		err := l.cfg.PreimageCache.SetPreimageExpiry(
+			payHash, expiryHeight,
+		)
+		if err != nil {
+			l.errorf("unable to set expiry of preimage=%x in "+
+				"cache: %v", preimage[:], err)
+		}
+	}()
+}
+
 // cleanupSpuriousResponse attempts to ack any AddRef or SettleFailRef
 // associated with this packet. If successful in doing so, it will also purge
 // the open circuit from the circuit map and remove the packet from the link's
@@ -1404,7 +1442,9 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 		// As we've learned of a new preimage for the first time, we'll
 		// add it to our preimage cache. By doing this, we ensure
 		// any contested contracts watched by any on-chain arbitrators
-		// can now sweep this HTLC on-chain.
+		// can now sweep this HTLC on-chain. The preimage will be marked
+		// to expire from the cache once the incoming link settles the
+		// HTLC.
 		go func() {
 			err := l.cfg.PreimageCache.AddPreimage(pre[:])
 			if err != nil {
@@ -45,6 +45,23 @@ func (m *mockPreimageCache) LookupPreimage(hash []byte) ([]byte, bool) {
 	return p, ok
 }
 
+func (m *mockPreimageCache) LookupPreimages(
+	hashes ...[]byte) ([][]byte, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	preimages := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		var h [32]byte
+		copy(h[:], hash)
+
+		preimages[i] = m.preimageMap[h]
+	}
+
+	return preimages, nil
+}
+
 func (m *mockPreimageCache) AddPreimage(preimage []byte) error {
 	m.Lock()
 	defer m.Unlock()
@@ -54,10 +71,33 @@ func (m *mockPreimageCache) AddPreimage(preimage []byte) error {
 	return nil
 }
 
+func (m *mockPreimageCache) AddPreimages(preimages ...[]byte) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, preimage := range preimages {
+		m.preimageMap[sha256.Sum256(preimage[:])] = preimage
+	}
+
+	return nil
+}
+
 func (m *mockPreimageCache) SubscribeUpdates() *contractcourt.WitnessSubscription {
 	return nil
 }
 
+func (m *mockPreimageCache) SubscribeUpdatesForHashSince(payHash [32]byte,
+	addIndex uint64) (*contractcourt.WitnessSubscription, error) {
+
+	return nil, nil
+}
+
+func (m *mockPreimageCache) SetPreimageExpiry(payHash [32]byte,
+	expiryHeight uint32) error {
+
+	return nil
+}
+
 type mockFeeEstimator struct {
 	byteFeeIn chan lnwallet.SatPerKWeight
 
@@ -337,6 +337,26 @@ func (i *invoiceRegistry) LookupInvoice(rHash chainhash.Hash) (channeldb.Invoice
 	return invoice, uint32(payReq.MinFinalCLTVExpiry()), nil
 }
 
+// LookupInvoiceTerms looks up only the contract terms of the invoice paying
+// to the passed payment hash (R-Hash). Unlike LookupInvoice, the payment
+// request isn't decoded and the remainder of the invoice isn't copied, so
+// this should be preferred by callers that are only interested in the
+// preimage or settlement status of an invoice.
+func (i *invoiceRegistry) LookupInvoiceTerms(rHash chainhash.Hash) (channeldb.ContractTerm, error) {
+	// First check the in-memory debug invoice index to see if this is an
+	// existing invoice added for debugging.
+	i.RLock()
+	debugInv, ok := i.debugInvoices[rHash]
+	i.RUnlock()
+
+	// If found, then simply return the terms of the invoice directly.
+	if ok {
+		return debugInv.Terms, nil
+	}
+
+	return i.cdb.LookupInvoiceTerms(rHash)
+}
+
 // SettleInvoice attempts to mark an invoice as settled. If the invoice is a
 // debug invoice, then this method is a noop as debug invoices are never fully
 // settled.
@@ -370,6 +390,47 @@ func (i *invoiceRegistry) SettleInvoice(rHash chainhash.Hash,
 	return nil
 }
 
+// SettleInvoices attempts to mark a batch of invoices as settled within a
+// single database transaction. Any debug invoices within the batch are
+// skipped, as they're never fully settled. Once the batch has been written,
+// all subscribers are notified of each settled invoice in turn. If any
+// invoice in the batch can't be settled, then none of them will be.
+func (i *invoiceRegistry) SettleInvoices(
+	settlements []channeldb.InvoiceSettlement) error {
+
+	i.Lock()
+	defer i.Unlock()
+
+	// First, filter out any debug invoices from the batch.
+	dbSettlements := make([]channeldb.InvoiceSettlement, 0, len(settlements))
+	for _, settlement := range settlements {
+		if _, ok := i.debugInvoices[settlement.PaymentHash]; ok {
+			continue
+		}
+
+		ltndLog.Debugf("Settling invoice %x", settlement.PaymentHash[:])
+
+		dbSettlements = append(dbSettlements, settlement)
+	}
+
+	if len(dbSettlements) == 0 {
+		return nil
+	}
+
+	invoices, err := i.cdb.SettleInvoices(dbSettlements)
+	if err != nil {
+		return err
+	}
+
+	for _, invoice := range invoices {
+		ltndLog.Infof("Payment received: %v", spew.Sdump(invoice))
+
+		i.notifyClients(invoice, true)
+	}
+
+	return nil
+}
+
 // notifyClients notifies all currently registered invoice notification clients
 // of a newly added/settled invoice.
 func (i *invoiceRegistry) notifyClients(invoice *channeldb.Invoice, settle bool) {
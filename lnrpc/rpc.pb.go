@@ -101,6 +101,10 @@ It has these top-level messages:
 	ListPaymentsResponse
 	DeleteAllPaymentsRequest
 	DeleteAllPaymentsResponse
+	DeletePaymentRequest
+	DeletePaymentResponse
+	DeletePaymentsBeforeRequest
+	DeletePaymentsBeforeResponse
 	AbandonChannelRequest
 	AbandonChannelResponse
 	DebugLevelRequest
@@ -197,6 +201,32 @@ func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor0, []int{35, 0}
 }
 
+type ListPaymentsRequest_PaymentStatus int32
+
+const (
+	ListPaymentsRequest_GROUNDED  ListPaymentsRequest_PaymentStatus = 0
+	ListPaymentsRequest_IN_FLIGHT ListPaymentsRequest_PaymentStatus = 1
+	ListPaymentsRequest_COMPLETED ListPaymentsRequest_PaymentStatus = 2
+)
+
+var ListPaymentsRequest_PaymentStatus_name = map[int32]string{
+	0: "GROUNDED",
+	1: "IN_FLIGHT",
+	2: "COMPLETED",
+}
+var ListPaymentsRequest_PaymentStatus_value = map[string]int32{
+	"GROUNDED":  0,
+	"IN_FLIGHT": 1,
+	"COMPLETED": 2,
+}
+
+func (x ListPaymentsRequest_PaymentStatus) String() string {
+	return proto.EnumName(ListPaymentsRequest_PaymentStatus_name, int32(x))
+}
+func (ListPaymentsRequest_PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{89, 0}
+}
+
 type GenSeedRequest struct {
 	// *
 	// aezeed_passphrase is an optional user provided passphrase that will be used
@@ -4345,6 +4375,26 @@ func (m *Payment) GetValueMsat() int64 {
 }
 
 type ListPaymentsRequest struct {
+	// *
+	// The index of a payment that will be used as either the start or end of a
+	// query to determine which payments should be returned in the response.
+	IndexOffset uint64 `protobuf:"varint,1,opt,name=index_offset" json:"index_offset,omitempty"`
+	// *
+	// The max number of payments to return in the response to this query. If
+	// zero, then all matching payments will be returned.
+	NumMaxPayments uint64 `protobuf:"varint,2,opt,name=num_max_payments" json:"num_max_payments,omitempty"`
+	// *
+	// If set, the payments returned will result from seeking backwards from the
+	// specified index offset. This can be used to paginate backwards.
+	Reversed bool `protobuf:"varint,3,opt,name=reversed" json:"reversed,omitempty"`
+	// / If non-zero, payments created before this unix timestamp are excluded.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time" json:"start_time,omitempty"`
+	// / If non-zero, payments created after this unix timestamp are excluded.
+	EndTime int64 `protobuf:"varint,5,opt,name=end_time" json:"end_time,omitempty"`
+	// *
+	// If non-empty, only payments currently having one of the listed statuses
+	// will be returned.
+	Statuses []ListPaymentsRequest_PaymentStatus `protobuf:"varint,6,rep,packed,name=statuses,enum=lnrpc.ListPaymentsRequest_PaymentStatus" json:"statuses,omitempty"`
 }
 
 func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
@@ -4352,9 +4402,59 @@ func (m *ListPaymentsRequest) String() string            { return proto.CompactT
 func (*ListPaymentsRequest) ProtoMessage()               {}
 func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
+func (m *ListPaymentsRequest) GetIndexOffset() uint64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *ListPaymentsRequest) GetNumMaxPayments() uint64 {
+	if m != nil {
+		return m.NumMaxPayments
+	}
+	return 0
+}
+
+func (m *ListPaymentsRequest) GetReversed() bool {
+	if m != nil {
+		return m.Reversed
+	}
+	return false
+}
+
+func (m *ListPaymentsRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ListPaymentsRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ListPaymentsRequest) GetStatuses() []ListPaymentsRequest_PaymentStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
 type ListPaymentsResponse struct {
 	// / The list of payments
 	Payments []*Payment `protobuf:"bytes,1,rep,name=payments" json:"payments,omitempty"`
+	// *
+	// The index of the last item in the set of returned payments. This can be
+	// used to seek further, pagination style.
+	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset" json:"last_index_offset,omitempty"`
+	// *
+	// The index of the first item in the set of returned payments. This can be
+	// used to seek backwards, pagination style.
+	FirstIndexOffset uint64 `protobuf:"varint,3,opt,name=first_index_offset" json:"first_index_offset,omitempty"`
 }
 
 func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
@@ -4369,6 +4469,20 @@ func (m *ListPaymentsResponse) GetPayments() []*Payment {
 	return nil
 }
 
+func (m *ListPaymentsResponse) GetLastIndexOffset() uint64 {
+	if m != nil {
+		return m.LastIndexOffset
+	}
+	return 0
+}
+
+func (m *ListPaymentsResponse) GetFirstIndexOffset() uint64 {
+	if m != nil {
+		return m.FirstIndexOffset
+	}
+	return 0
+}
+
 type DeleteAllPaymentsRequest struct {
 }
 
@@ -4385,6 +4499,76 @@ func (m *DeleteAllPaymentsResponse) String() string            { return proto.Co
 func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
 func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
+type DeletePaymentRequest struct {
+	// *
+	// The hex-encoded payment hash of the payment to be deleted. The passed
+	// payment hash must be exactly 32 bytes, otherwise an error is returned.
+	PaymentHashStr string `protobuf:"bytes,1,opt,name=payment_hash_str" json:"payment_hash_str,omitempty"`
+	// / The payment hash of the payment to be deleted.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+}
+
+func (m *DeletePaymentRequest) Reset()                    { *m = DeletePaymentRequest{} }
+func (m *DeletePaymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeletePaymentRequest) ProtoMessage()               {}
+func (*DeletePaymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+
+func (m *DeletePaymentRequest) GetPaymentHashStr() string {
+	if m != nil {
+		return m.PaymentHashStr
+	}
+	return ""
+}
+
+func (m *DeletePaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+type DeletePaymentResponse struct {
+}
+
+func (m *DeletePaymentResponse) Reset()                    { *m = DeletePaymentResponse{} }
+func (m *DeletePaymentResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeletePaymentResponse) ProtoMessage()               {}
+func (*DeletePaymentResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+
+type DeletePaymentsBeforeRequest struct {
+	// / All payments created before this unix timestamp will be deleted.
+	CreatedBefore int64 `protobuf:"varint,1,opt,name=created_before" json:"created_before,omitempty"`
+}
+
+func (m *DeletePaymentsBeforeRequest) Reset()                    { *m = DeletePaymentsBeforeRequest{} }
+func (m *DeletePaymentsBeforeRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeletePaymentsBeforeRequest) ProtoMessage()               {}
+func (*DeletePaymentsBeforeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+
+func (m *DeletePaymentsBeforeRequest) GetCreatedBefore() int64 {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return 0
+}
+
+type DeletePaymentsBeforeResponse struct {
+	// / The number of payments that were deleted.
+	NumDeleted uint64 `protobuf:"varint,1,opt,name=num_deleted" json:"num_deleted,omitempty"`
+}
+
+func (m *DeletePaymentsBeforeResponse) Reset()                    { *m = DeletePaymentsBeforeResponse{} }
+func (m *DeletePaymentsBeforeResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeletePaymentsBeforeResponse) ProtoMessage()               {}
+func (*DeletePaymentsBeforeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+
+func (m *DeletePaymentsBeforeResponse) GetNumDeleted() uint64 {
+	if m != nil {
+		return m.NumDeleted
+	}
+	return 0
+}
+
 type AbandonChannelRequest struct {
 	ChannelPoint *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint" json:"channel_point,omitempty"`
 }
@@ -4392,7 +4576,7 @@ type AbandonChannelRequest struct {
 func (m *AbandonChannelRequest) Reset()                    { *m = AbandonChannelRequest{} }
 func (m *AbandonChannelRequest) String() string            { return proto.CompactTextString(m) }
 func (*AbandonChannelRequest) ProtoMessage()               {}
-func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+func (*AbandonChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
 
 func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
@@ -4407,7 +4591,7 @@ type AbandonChannelResponse struct {
 func (m *AbandonChannelResponse) Reset()                    { *m = AbandonChannelResponse{} }
 func (m *AbandonChannelResponse) String() string            { return proto.CompactTextString(m) }
 func (*AbandonChannelResponse) ProtoMessage()               {}
-func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+func (*AbandonChannelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
 
 type DebugLevelRequest struct {
 	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
@@ -4417,7 +4601,7 @@ type DebugLevelRequest struct {
 func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()               {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
 func (m *DebugLevelRequest) GetShow() bool {
 	if m != nil {
@@ -4440,7 +4624,7 @@ type DebugLevelResponse struct {
 func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()               {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
 
 func (m *DebugLevelResponse) GetSubSystems() string {
 	if m != nil {
@@ -4457,7 +4641,7 @@ type PayReqString struct {
 func (m *PayReqString) Reset()                    { *m = PayReqString{} }
 func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()               {}
-func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
 
 func (m *PayReqString) GetPayReq() string {
 	if m != nil {
@@ -4482,7 +4666,7 @@ type PayReq struct {
 func (m *PayReq) Reset()                    { *m = PayReq{} }
 func (m *PayReq) String() string            { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()               {}
-func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
 
 func (m *PayReq) GetDestination() string {
 	if m != nil {
@@ -4560,7 +4744,7 @@ type FeeReportRequest struct {
 func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()               {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
 type ChannelFeeReport struct {
 	// / The channel that this fee report belongs to.
@@ -4576,7 +4760,7 @@ type ChannelFeeReport struct {
 func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()               {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
 
 func (m *ChannelFeeReport) GetChanPoint() string {
 	if m != nil {
@@ -4620,7 +4804,7 @@ type FeeReportResponse struct {
 func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()               {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
 func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
 	if m != nil {
@@ -4666,7 +4850,7 @@ type PolicyUpdateRequest struct {
 func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()               {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
 
 type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
 
@@ -4801,7 +4985,7 @@ type PolicyUpdateResponse struct {
 func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()               {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
 
 type ForwardingHistoryRequest struct {
 	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
@@ -4817,7 +5001,7 @@ type ForwardingHistoryRequest struct {
 func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
 func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()               {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
 
 func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
 	if m != nil {
@@ -4865,7 +5049,7 @@ type ForwardingEvent struct {
 func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()               {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
 
 func (m *ForwardingEvent) GetTimestamp() uint64 {
 	if m != nil {
@@ -4919,7 +5103,7 @@ type ForwardingHistoryResponse struct {
 func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
 func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()               {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
 
 func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
 	if m != nil {
@@ -5034,6 +5218,10 @@ func init() {
 	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
 	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
 	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
+	proto.RegisterType((*DeletePaymentRequest)(nil), "lnrpc.DeletePaymentRequest")
+	proto.RegisterType((*DeletePaymentResponse)(nil), "lnrpc.DeletePaymentResponse")
+	proto.RegisterType((*DeletePaymentsBeforeRequest)(nil), "lnrpc.DeletePaymentsBeforeRequest")
+	proto.RegisterType((*DeletePaymentsBeforeResponse)(nil), "lnrpc.DeletePaymentsBeforeResponse")
 	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
 	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
 	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
@@ -5050,6 +5238,7 @@ func init() {
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
 	proto.RegisterEnum("lnrpc.NewAddressRequest_AddressType", NewAddressRequest_AddressType_name, NewAddressRequest_AddressType_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+	proto.RegisterEnum("lnrpc.ListPaymentsRequest_PaymentStatus", ListPaymentsRequest_PaymentStatus_name, ListPaymentsRequest_PaymentStatus_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5447,11 +5636,24 @@ type LightningClient interface {
 	// payment request.
 	DecodePayReq(ctx context.Context, in *PayReqString, opts ...grpc.CallOption) (*PayReq, error)
 	// * lncli: `listpayments`
-	// ListPayments returns a list of all outgoing payments.
+	// ListPayments returns a list of all outgoing payments. It has support for
+	// paginated responses, allowing users to query for specific payments through
+	// their payment index. This can be done by using either the
+	// first_index_offset or last_index_offset fields included in the response as
+	// the index_offset of the next request. If none of the parameters are
+	// specified, then all payments will be returned.
 	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
 	// *
 	// DeleteAllPayments deletes all outgoing payments from DB.
 	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `deletepayment`
+	// DeletePayment deletes the outgoing payment paying to the specified payment
+	// hash from DB.
+	DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*DeletePaymentResponse, error)
+	// * lncli: `deletepayments`
+	// DeletePaymentsBefore deletes all outgoing payments created before the
+	// specified time from DB.
+	DeletePaymentsBefore(ctx context.Context, in *DeletePaymentsBeforeRequest, opts ...grpc.CallOption) (*DeletePaymentsBeforeResponse, error)
 	// * lncli: `describegraph`
 	// DescribeGraph returns a description of the latest graph state from the
 	// point of view of the node. The graph information is partitioned into two
@@ -5944,6 +6146,24 @@ func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPa
 	return out, nil
 }
 
+func (c *lightningClient) DeletePayment(ctx context.Context, in *DeletePaymentRequest, opts ...grpc.CallOption) (*DeletePaymentResponse, error) {
+	out := new(DeletePaymentResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeletePayment", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeletePaymentsBefore(ctx context.Context, in *DeletePaymentsBeforeRequest, opts ...grpc.CallOption) (*DeletePaymentsBeforeResponse, error) {
+	out := new(DeletePaymentsBeforeResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeletePaymentsBefore", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
 	out := new(ChannelGraph)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, c.cc, opts...)
@@ -6234,11 +6454,24 @@ type LightningServer interface {
 	// payment request.
 	DecodePayReq(context.Context, *PayReqString) (*PayReq, error)
 	// * lncli: `listpayments`
-	// ListPayments returns a list of all outgoing payments.
+	// ListPayments returns a list of all outgoing payments. It has support for
+	// paginated responses, allowing users to query for specific payments through
+	// their payment index. This can be done by using either the
+	// first_index_offset or last_index_offset fields included in the response as
+	// the index_offset of the next request. If none of the parameters are
+	// specified, then all payments will be returned.
 	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
 	// *
 	// DeleteAllPayments deletes all outgoing payments from DB.
 	DeleteAllPayments(context.Context, *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `deletepayment`
+	// DeletePayment deletes the outgoing payment paying to the specified payment
+	// hash from DB.
+	DeletePayment(context.Context, *DeletePaymentRequest) (*DeletePaymentResponse, error)
+	// * lncli: `deletepayments`
+	// DeletePaymentsBefore deletes all outgoing payments created before the
+	// specified time from DB.
+	DeletePaymentsBefore(context.Context, *DeletePaymentsBeforeRequest) (*DeletePaymentsBeforeResponse, error)
 	// * lncli: `describegraph`
 	// DescribeGraph returns a description of the latest graph state from the
 	// point of view of the node. The graph information is partitioned into two
@@ -6898,6 +7131,42 @@ func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_DeletePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeletePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeletePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeletePayment(ctx, req.(*DeletePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DeletePaymentsBefore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentsBeforeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeletePaymentsBefore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeletePaymentsBefore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeletePaymentsBefore(ctx, req.(*DeletePaymentsBeforeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_DescribeGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ChannelGraphRequest)
 	if err := dec(in); err != nil {
@@ -7203,6 +7472,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAllPayments",
 			Handler:    _Lightning_DeleteAllPayments_Handler,
 		},
+		{
+			MethodName: "DeletePayment",
+			Handler:    _Lightning_DeletePayment_Handler,
+		},
+		{
+			MethodName: "DeletePaymentsBefore",
+			Handler:    _Lightning_DeletePaymentsBefore_Handler,
+		},
 		{
 			MethodName: "DescribeGraph",
 			Handler:    _Lightning_DescribeGraph_Handler,
@@ -7289,409 +7566,421 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 6456 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x4b, 0x6c, 0x1c, 0xd9,
-	0x75, 0xb6, 0xaa, 0xd9, 0x4d, 0x76, 0x9f, 0x6e, 0x92, 0xcd, 0xcb, 0x87, 0x5a, 0x3d, 0x1a, 0x8d,
-	0xa6, 0x3c, 0x18, 0xc9, 0xfc, 0xe7, 0x97, 0x34, 0xb4, 0x3d, 0x18, 0xcf, 0xfc, 0xbf, 0xfd, 0x53,
-	0x24, 0x25, 0xca, 0xe6, 0x48, 0x74, 0x51, 0xe3, 0xf9, 0x6d, 0x27, 0x68, 0x17, 0xbb, 0x2f, 0x9b,
-	0x65, 0x75, 0x57, 0xb5, 0xab, 0xaa, 0xc9, 0x69, 0x4f, 0x06, 0xc8, 0x0b, 0x09, 0x10, 0xc4, 0x30,
-	0x82, 0x04, 0x08, 0x1c, 0x20, 0x08, 0xe0, 0x64, 0x61, 0x2f, 0xb3, 0x88, 0x37, 0x49, 0x76, 0xd9,
-	0x24, 0x40, 0x90, 0x85, 0x57, 0x81, 0x81, 0x6c, 0x92, 0x4d, 0x92, 0x5d, 0x80, 0x2c, 0x13, 0x04,
-	0xe7, 0xdc, 0x47, 0xdd, 0x5b, 0x55, 0x14, 0xe5, 0x47, 0xb2, 0xeb, 0xfb, 0xdd, 0x53, 0xf7, 0x79,
-	0x5e, 0xf7, 0xdc, 0x73, 0x1b, 0x1a, 0xf1, 0xa4, 0x7f, 0x67, 0x12, 0x47, 0x69, 0xc4, 0x6a, 0xa3,
-	0x30, 0x9e, 0xf4, 0xbb, 0xd7, 0x87, 0x51, 0x34, 0x1c, 0xf1, 0xbb, 0xfe, 0x24, 0xb8, 0xeb, 0x87,
-	0x61, 0x94, 0xfa, 0x69, 0x10, 0x85, 0x89, 0x20, 0x72, 0xbf, 0x0e, 0x4b, 0x0f, 0x79, 0x78, 0xc4,
-	0xf9, 0xc0, 0xe3, 0xdf, 0x9c, 0xf2, 0x24, 0x65, 0xff, 0x0b, 0x56, 0x7c, 0xfe, 0x2d, 0xce, 0x07,
-	0xbd, 0x89, 0x9f, 0x24, 0x93, 0xd3, 0xd8, 0x4f, 0x78, 0xc7, 0xb9, 0xe9, 0xdc, 0x6e, 0x79, 0x6d,
-	0x51, 0x71, 0xa8, 0x71, 0xf6, 0x2a, 0xb4, 0x12, 0x24, 0xe5, 0x61, 0x1a, 0x47, 0x93, 0x59, 0xa7,
-	0x42, 0x74, 0x4d, 0xc4, 0xf6, 0x04, 0xe4, 0x8e, 0x60, 0x59, 0xf7, 0x90, 0x4c, 0xa2, 0x30, 0xe1,
-	0xec, 0x1e, 0xac, 0xf5, 0x83, 0xc9, 0x29, 0x8f, 0x7b, 0xf4, 0xf1, 0x38, 0xe4, 0xe3, 0x28, 0x0c,
-	0xfa, 0x1d, 0xe7, 0xe6, 0xdc, 0xed, 0x86, 0xc7, 0x44, 0x1d, 0x7e, 0xf1, 0x9e, 0xac, 0x61, 0xb7,
-	0x60, 0x99, 0x87, 0x02, 0xe7, 0x03, 0xfa, 0x4a, 0x76, 0xb5, 0x94, 0xc1, 0xf8, 0x81, 0xfb, 0x57,
-	0x0e, 0xac, 0x3c, 0x0a, 0x83, 0xf4, 0x03, 0x7f, 0x34, 0xe2, 0xa9, 0x9a, 0xd3, 0x2d, 0x58, 0x3e,
-	0x27, 0x80, 0xe6, 0x74, 0x1e, 0xc5, 0x03, 0x39, 0xa3, 0x25, 0x01, 0x1f, 0x4a, 0xf4, 0xc2, 0x91,
-	0x55, 0x2e, 0x1c, 0x59, 0xe9, 0x72, 0xcd, 0x5d, 0xb0, 0x5c, 0xb7, 0x60, 0x39, 0xe6, 0xfd, 0xe8,
-	0x8c, 0xc7, 0xb3, 0xde, 0x79, 0x10, 0x0e, 0xa2, 0xf3, 0x4e, 0xf5, 0xa6, 0x73, 0xbb, 0xe6, 0x2d,
-	0x29, 0xf8, 0x03, 0x42, 0xdd, 0x35, 0x60, 0xe6, 0x2c, 0xc4, 0xba, 0xb9, 0x43, 0x58, 0x7d, 0x3f,
-	0x1c, 0x45, 0xfd, 0x67, 0x3f, 0xe5, 0xec, 0x4a, 0xba, 0xaf, 0x94, 0x76, 0xbf, 0x01, 0x6b, 0x76,
-	0x47, 0x72, 0x00, 0x1c, 0xd6, 0x77, 0x4e, 0xfd, 0x70, 0xc8, 0x55, 0x93, 0x6a, 0x08, 0x9f, 0x84,
-	0x76, 0x7f, 0x1a, 0xc7, 0x3c, 0x2c, 0x8c, 0x61, 0x59, 0xe2, 0x7a, 0x10, 0xaf, 0x42, 0x2b, 0xe4,
-	0xe7, 0x19, 0x99, 0x64, 0x99, 0x90, 0x9f, 0x2b, 0x12, 0xb7, 0x03, 0x1b, 0xf9, 0x6e, 0xe4, 0x00,
-	0xbe, 0x5b, 0x81, 0xe6, 0xd3, 0xd8, 0x0f, 0x13, 0xbf, 0x8f, 0x5c, 0xcc, 0x3a, 0xb0, 0x90, 0x7e,
-	0xd8, 0x3b, 0xf5, 0x93, 0x53, 0xea, 0xae, 0xe1, 0xa9, 0x22, 0xdb, 0x80, 0x79, 0x7f, 0x1c, 0x4d,
-	0xc3, 0x94, 0x3a, 0x98, 0xf3, 0x64, 0x89, 0xbd, 0x01, 0x2b, 0xe1, 0x74, 0xdc, 0xeb, 0x47, 0xe1,
-	0x49, 0x10, 0x8f, 0x85, 0x2c, 0xd0, 0x7e, 0xd5, 0xbc, 0x62, 0x05, 0xbb, 0x01, 0x70, 0x8c, 0xeb,
-	0x20, 0xba, 0xa8, 0x52, 0x17, 0x06, 0xc2, 0x5c, 0x68, 0xc9, 0x12, 0x0f, 0x86, 0xa7, 0x69, 0xa7,
-	0x46, 0x0d, 0x59, 0x18, 0xb6, 0x91, 0x06, 0x63, 0xde, 0x4b, 0x52, 0x7f, 0x3c, 0xe9, 0xcc, 0xd3,
-	0x68, 0x0c, 0x84, 0xea, 0xa3, 0xd4, 0x1f, 0xf5, 0x4e, 0x38, 0x4f, 0x3a, 0x0b, 0xb2, 0x5e, 0x23,
-	0xec, 0x75, 0x58, 0x1a, 0xf0, 0x24, 0xed, 0xf9, 0x83, 0x41, 0xcc, 0x93, 0x84, 0x27, 0x9d, 0x3a,
-	0x71, 0x63, 0x0e, 0xc5, 0x55, 0x7b, 0xc8, 0x53, 0x63, 0x75, 0x12, 0xb9, 0x3b, 0xee, 0x01, 0x30,
-	0x03, 0xde, 0xe5, 0xa9, 0x1f, 0x8c, 0x12, 0xf6, 0x16, 0xb4, 0x52, 0x83, 0x98, 0xa4, 0xaf, 0xb9,
-	0xc5, 0xee, 0x90, 0xda, 0xb8, 0x63, 0x7c, 0xe0, 0x59, 0x74, 0xee, 0x43, 0xa8, 0x3f, 0xe0, 0xfc,
-	0x20, 0x18, 0x07, 0x29, 0xdb, 0x80, 0xda, 0x49, 0xf0, 0x21, 0x17, 0x9b, 0x3d, 0xb7, 0x7f, 0xc5,
-	0x13, 0x45, 0xd6, 0x85, 0x85, 0x09, 0x8f, 0xfb, 0x5c, 0x2d, 0xff, 0xfe, 0x15, 0x4f, 0x01, 0xf7,
-	0x17, 0xa0, 0x36, 0xc2, 0x8f, 0xdd, 0xef, 0x57, 0xa0, 0x79, 0xc4, 0x43, 0xcd, 0x44, 0x0c, 0xaa,
-	0x38, 0x25, 0xc9, 0x38, 0xf4, 0x9b, 0xbd, 0x02, 0x4d, 0x9a, 0x66, 0x92, 0xc6, 0x41, 0x38, 0xa4,
-	0xc6, 0x1a, 0x1e, 0x20, 0x74, 0x44, 0x08, 0x6b, 0xc3, 0x9c, 0x3f, 0x4e, 0x69, 0x07, 0xe7, 0x3c,
-	0xfc, 0x89, 0x0c, 0x36, 0xf1, 0x67, 0x63, 0xe4, 0x45, 0xbd, 0x6b, 0x2d, 0xaf, 0x29, 0xb1, 0x7d,
-	0xdc, 0xb6, 0x3b, 0xb0, 0x6a, 0x92, 0xa8, 0xd6, 0x6b, 0xd4, 0xfa, 0x8a, 0x41, 0x29, 0x3b, 0xb9,
-	0x05, 0xcb, 0x8a, 0x3e, 0x16, 0x83, 0xa5, 0x7d, 0x6c, 0x78, 0x4b, 0x12, 0x56, 0x53, 0xb8, 0x0d,
-	0xed, 0x93, 0x20, 0xf4, 0x47, 0xbd, 0xfe, 0x28, 0x3d, 0xeb, 0x0d, 0xf8, 0x28, 0xf5, 0x69, 0x47,
-	0x6b, 0xde, 0x12, 0xe1, 0x3b, 0xa3, 0xf4, 0x6c, 0x17, 0x51, 0xf6, 0x06, 0x34, 0x4e, 0x38, 0xef,
-	0xd1, 0x4a, 0x74, 0xea, 0x37, 0x9d, 0xdb, 0xcd, 0xad, 0x65, 0xb9, 0xf4, 0x6a, 0x75, 0xbd, 0xfa,
-	0x89, 0xfc, 0xe5, 0xfe, 0x9e, 0x03, 0x2d, 0xb1, 0x54, 0x52, 0x85, 0xbe, 0x06, 0x8b, 0x6a, 0x44,
-	0x3c, 0x8e, 0xa3, 0x58, 0xb2, 0xbf, 0x0d, 0xb2, 0x4d, 0x68, 0x2b, 0x60, 0x12, 0xf3, 0x60, 0xec,
-	0x0f, 0xb9, 0x94, 0xb7, 0x02, 0xce, 0xb6, 0xb2, 0x16, 0xe3, 0x68, 0x9a, 0x0a, 0x25, 0xd6, 0xdc,
-	0x6a, 0xc9, 0x41, 0x79, 0x88, 0x79, 0x36, 0x89, 0xfb, 0x6d, 0x07, 0x18, 0x0e, 0xeb, 0x69, 0x24,
-	0xaa, 0xe5, 0x2a, 0xe4, 0x77, 0xc0, 0x79, 0xe1, 0x1d, 0xa8, 0x5c, 0xb4, 0x03, 0xaf, 0xc1, 0x3c,
-	0x75, 0x89, 0xb2, 0x3a, 0x57, 0x18, 0x96, 0xac, 0x73, 0xbf, 0xe7, 0x40, 0x0b, 0x35, 0x47, 0xc8,
-	0x47, 0x87, 0x51, 0x10, 0xa6, 0xec, 0x1e, 0xb0, 0x93, 0x69, 0x38, 0x08, 0xc2, 0x61, 0x2f, 0xfd,
-	0x30, 0x18, 0xf4, 0x8e, 0x67, 0xd8, 0x04, 0x8d, 0x67, 0xff, 0x8a, 0x57, 0x52, 0xc7, 0xde, 0x80,
-	0xb6, 0x85, 0x26, 0x69, 0x2c, 0x46, 0xb5, 0x7f, 0xc5, 0x2b, 0xd4, 0xa0, 0xfc, 0x47, 0xd3, 0x74,
-	0x32, 0x4d, 0x7b, 0x41, 0x38, 0xe0, 0x1f, 0xd2, 0x9a, 0x2d, 0x7a, 0x16, 0x76, 0x7f, 0x09, 0x5a,
-	0xe6, 0x77, 0xee, 0xe7, 0xa0, 0x7d, 0x80, 0x8a, 0x21, 0x0c, 0xc2, 0xe1, 0xb6, 0x90, 0x5e, 0xd4,
-	0x56, 0x93, 0xe9, 0xf1, 0x33, 0x3e, 0x93, 0xfb, 0x28, 0x4b, 0x28, 0x12, 0xa7, 0x51, 0x92, 0xca,
-	0x75, 0xa1, 0xdf, 0xee, 0x3f, 0x3a, 0xb0, 0x8c, 0x8b, 0xfe, 0x9e, 0x1f, 0xce, 0xd4, 0x8a, 0x1f,
-	0x40, 0x0b, 0x9b, 0x7a, 0x1a, 0x6d, 0x0b, 0x9d, 0x27, 0x64, 0xf9, 0xb6, 0x5c, 0xa4, 0x1c, 0xf5,
-	0x1d, 0x93, 0x14, 0xcd, 0xf4, 0xcc, 0xb3, 0xbe, 0x46, 0xa1, 0x4b, 0xfd, 0x78, 0xc8, 0x53, 0xd2,
-	0x86, 0x52, 0x3b, 0x82, 0x80, 0x76, 0xa2, 0xf0, 0x84, 0xdd, 0x84, 0x56, 0xe2, 0xa7, 0xbd, 0x09,
-	0x8f, 0x69, 0xd5, 0x48, 0x70, 0xe6, 0x3c, 0x48, 0xfc, 0xf4, 0x90, 0xc7, 0xf7, 0x67, 0x29, 0xef,
-	0x7e, 0x1e, 0x56, 0x0a, 0xbd, 0xa0, 0xac, 0x66, 0x53, 0xc4, 0x9f, 0x6c, 0x0d, 0x6a, 0x67, 0xfe,
-	0x68, 0xca, 0xa5, 0x92, 0x16, 0x85, 0x77, 0x2a, 0x6f, 0x3b, 0xee, 0xeb, 0xd0, 0xce, 0x86, 0x2d,
-	0x99, 0x9e, 0x41, 0x15, 0x57, 0x50, 0x36, 0x40, 0xbf, 0xdd, 0x5f, 0x71, 0x04, 0xe1, 0x4e, 0x14,
-	0x68, 0x85, 0x87, 0x84, 0xa8, 0x17, 0x15, 0x21, 0xfe, 0xbe, 0xd0, 0x20, 0xfc, 0xec, 0x93, 0x75,
-	0x6f, 0xc1, 0x8a, 0x31, 0x84, 0xe7, 0x0c, 0xf6, 0xdb, 0x0e, 0xac, 0x3c, 0xe6, 0xe7, 0x72, 0xd7,
-	0xd5, 0x68, 0xdf, 0x86, 0x6a, 0x3a, 0x9b, 0x08, 0x27, 0x6b, 0x69, 0xeb, 0x35, 0xb9, 0x69, 0x05,
-	0xba, 0x3b, 0xb2, 0xf8, 0x74, 0x36, 0xe1, 0x1e, 0x7d, 0xe1, 0x7e, 0x0e, 0x9a, 0x06, 0xc8, 0xae,
-	0xc2, 0xea, 0x07, 0x8f, 0x9e, 0x3e, 0xde, 0x3b, 0x3a, 0xea, 0x1d, 0xbe, 0x7f, 0xff, 0x8b, 0x7b,
-	0x5f, 0xe9, 0xed, 0x6f, 0x1f, 0xed, 0xb7, 0xaf, 0xb0, 0x0d, 0x60, 0x8f, 0xf7, 0x8e, 0x9e, 0xee,
-	0xed, 0x5a, 0xb8, 0xe3, 0xde, 0x01, 0x66, 0x76, 0x23, 0x47, 0xde, 0x81, 0x05, 0x69, 0x55, 0x94,
-	0x51, 0x95, 0x45, 0xf7, 0x75, 0x60, 0x47, 0xc1, 0x30, 0x7c, 0x8f, 0x27, 0x89, 0x3f, 0xd4, 0xe2,
-	0xde, 0x86, 0xb9, 0x71, 0x32, 0x94, 0x52, 0x8e, 0x3f, 0xdd, 0x4f, 0xc1, 0xaa, 0x45, 0x27, 0x1b,
-	0xbe, 0x0e, 0x8d, 0x24, 0x18, 0x86, 0x7e, 0x3a, 0x8d, 0xb9, 0x6c, 0x3a, 0x03, 0xdc, 0x07, 0xb0,
-	0xf6, 0x65, 0x1e, 0x07, 0x27, 0xb3, 0xcb, 0x9a, 0xb7, 0xdb, 0xa9, 0xe4, 0xdb, 0xd9, 0x83, 0xf5,
-	0x5c, 0x3b, 0xb2, 0x7b, 0xc1, 0x6c, 0x72, 0x4b, 0xea, 0x9e, 0x28, 0x18, 0xa2, 0x57, 0x31, 0x45,
-	0xcf, 0x7d, 0x1f, 0xd8, 0x4e, 0x14, 0x86, 0xbc, 0x9f, 0x1e, 0x72, 0x1e, 0x67, 0xde, 0x71, 0xc6,
-	0x59, 0xcd, 0xad, 0xab, 0x72, 0xaf, 0xf2, 0xf2, 0x2c, 0x59, 0x8e, 0x41, 0x75, 0xc2, 0xe3, 0x31,
-	0x35, 0x5c, 0xf7, 0xe8, 0xb7, 0xbb, 0x0e, 0xab, 0x56, 0xb3, 0xd2, 0xb1, 0x79, 0x13, 0xd6, 0x77,
-	0x83, 0xa4, 0x5f, 0xec, 0xb0, 0x03, 0x0b, 0x93, 0xe9, 0x71, 0x2f, 0x93, 0x1b, 0x55, 0x44, 0x7b,
-	0x9f, 0xff, 0x44, 0x36, 0xf6, 0x1b, 0x0e, 0x54, 0xf7, 0x9f, 0x1e, 0xec, 0xb0, 0x2e, 0xd4, 0x83,
-	0xb0, 0x1f, 0x8d, 0x51, 0xb5, 0x8a, 0x49, 0xeb, 0xf2, 0x85, 0xf2, 0x70, 0x1d, 0x1a, 0xa4, 0x91,
-	0xd1, 0x85, 0x91, 0x8e, 0x6c, 0x06, 0xa0, 0xfb, 0xc4, 0x3f, 0x9c, 0x04, 0x31, 0xf9, 0x47, 0xca,
-	0xeb, 0xa9, 0x92, 0xd6, 0x2b, 0x56, 0xb8, 0xff, 0x59, 0x85, 0x05, 0xa9, 0x8f, 0xa9, 0xbf, 0x7e,
-	0x1a, 0x9c, 0x71, 0x39, 0x12, 0x59, 0x42, 0x4b, 0x16, 0xf3, 0x71, 0x94, 0xf2, 0x9e, 0xb5, 0x0d,
-	0x36, 0x88, 0x54, 0x7d, 0xd1, 0x50, 0x6f, 0x82, 0x9a, 0x9d, 0x46, 0xd6, 0xf0, 0x6c, 0x10, 0x17,
-	0x0b, 0x81, 0x5e, 0x30, 0xa0, 0x31, 0x55, 0x3d, 0x55, 0xc4, 0x95, 0xe8, 0xfb, 0x13, 0xbf, 0x1f,
-	0xa4, 0x33, 0x29, 0xc0, 0xba, 0x8c, 0x6d, 0x8f, 0xa2, 0xbe, 0x3f, 0xea, 0x1d, 0xfb, 0x23, 0x3f,
-	0xec, 0x73, 0xe9, 0xa3, 0xd9, 0x20, 0xba, 0x61, 0x72, 0x48, 0x8a, 0x4c, 0xb8, 0x6a, 0x39, 0x14,
-	0xdd, 0xb9, 0x7e, 0x34, 0x1e, 0x07, 0x29, 0x7a, 0x6f, 0x64, 0xd9, 0xe7, 0x3c, 0x03, 0xa1, 0x99,
-	0x88, 0xd2, 0xb9, 0x58, 0xbd, 0x86, 0xe8, 0xcd, 0x02, 0xb1, 0x15, 0x74, 0x0f, 0x50, 0xe9, 0x3c,
-	0x3b, 0xef, 0x80, 0x68, 0x25, 0x43, 0x70, 0x1f, 0xa6, 0x61, 0xc2, 0xd3, 0x74, 0xc4, 0x07, 0x7a,
-	0x40, 0x4d, 0x22, 0x2b, 0x56, 0xb0, 0x7b, 0xb0, 0x2a, 0x1c, 0xca, 0xc4, 0x4f, 0xa3, 0xe4, 0x34,
-	0x48, 0x7a, 0x09, 0xba, 0x66, 0x2d, 0xa2, 0x2f, 0xab, 0x62, 0x6f, 0xc3, 0xd5, 0x1c, 0x1c, 0xf3,
-	0x3e, 0x0f, 0xce, 0xf8, 0xa0, 0xb3, 0x48, 0x5f, 0x5d, 0x54, 0xcd, 0x6e, 0x42, 0x13, 0xfd, 0xe8,
-	0xe9, 0x64, 0xe0, 0xa3, 0xad, 0x5d, 0xa2, 0x7d, 0x30, 0x21, 0xf6, 0x26, 0x2c, 0x4e, 0xb8, 0x30,
-	0x88, 0xa7, 0xe9, 0xa8, 0x9f, 0x74, 0x96, 0xc9, 0x5a, 0x35, 0xa5, 0x30, 0x21, 0xe7, 0x7a, 0x36,
-	0x05, 0x32, 0x65, 0x3f, 0x21, 0x87, 0xca, 0x9f, 0x75, 0xda, 0xc4, 0x6e, 0x19, 0x40, 0x32, 0x12,
-	0x07, 0x67, 0x7e, 0xca, 0x3b, 0x2b, 0xc4, 0x5b, 0xaa, 0xe8, 0xfe, 0x91, 0x03, 0xab, 0x07, 0x41,
-	0x92, 0x4a, 0x26, 0xd4, 0x2a, 0xf7, 0x15, 0x68, 0x0a, 0xf6, 0xeb, 0x45, 0xe1, 0x68, 0x26, 0x39,
-	0x12, 0x04, 0xf4, 0x24, 0x1c, 0xcd, 0xd8, 0x27, 0x60, 0x31, 0x08, 0x4d, 0x12, 0x21, 0xc3, 0x2d,
-	0x05, 0x12, 0xd1, 0x2b, 0xd0, 0x9c, 0x4c, 0x8f, 0x47, 0x41, 0x5f, 0x90, 0xcc, 0x89, 0x56, 0x04,
-	0x44, 0x04, 0xe8, 0x08, 0x89, 0x91, 0x08, 0x8a, 0x2a, 0x51, 0x34, 0x25, 0x86, 0x24, 0xee, 0x7d,
-	0x58, 0xb3, 0x07, 0x28, 0x95, 0xd5, 0x26, 0xd4, 0x25, 0x6f, 0x27, 0x9d, 0x26, 0xad, 0xcf, 0x92,
-	0x5c, 0x1f, 0x49, 0xea, 0xe9, 0x7a, 0xf7, 0x87, 0x55, 0x58, 0x95, 0xe8, 0xce, 0x28, 0x4a, 0xf8,
-	0xd1, 0x74, 0x3c, 0xf6, 0xe3, 0x12, 0xa1, 0x71, 0x2e, 0x11, 0x9a, 0x8a, 0x2d, 0x34, 0xc8, 0xca,
-	0xa7, 0x7e, 0x10, 0x0a, 0x2f, 0x4e, 0x48, 0x9c, 0x81, 0xb0, 0xdb, 0xb0, 0xdc, 0x1f, 0x45, 0x89,
-	0xf0, 0x6c, 0xcc, 0x23, 0x52, 0x1e, 0x2e, 0x0a, 0x79, 0xad, 0x4c, 0xc8, 0x4d, 0x21, 0x9d, 0xcf,
-	0x09, 0xa9, 0x0b, 0x2d, 0x6c, 0x94, 0x2b, 0x9d, 0xb3, 0x20, 0x3c, 0x2d, 0x13, 0xc3, 0xf1, 0xe4,
-	0x45, 0x42, 0xc8, 0xdf, 0x72, 0x99, 0x40, 0xe0, 0x09, 0x0c, 0x75, 0x9a, 0x41, 0xdd, 0x90, 0x02,
-	0x51, 0xac, 0x62, 0x0f, 0x00, 0x44, 0x5f, 0x64, 0xaa, 0x81, 0x4c, 0xf5, 0xeb, 0xf6, 0x8e, 0x98,
-	0x6b, 0x7f, 0x07, 0x0b, 0xd3, 0x98, 0x93, 0xb1, 0x36, 0xbe, 0x74, 0x7f, 0xcb, 0x81, 0xa6, 0x51,
-	0xc7, 0xd6, 0x61, 0x65, 0xe7, 0xc9, 0x93, 0xc3, 0x3d, 0x6f, 0xfb, 0xe9, 0xa3, 0x2f, 0xef, 0xf5,
-	0x76, 0x0e, 0x9e, 0x1c, 0xed, 0xb5, 0xaf, 0x20, 0x7c, 0xf0, 0x64, 0x67, 0xfb, 0xa0, 0xf7, 0xe0,
-	0x89, 0xb7, 0xa3, 0x60, 0x07, 0x0d, 0xb9, 0xb7, 0xf7, 0xde, 0x93, 0xa7, 0x7b, 0x16, 0x5e, 0x61,
-	0x6d, 0x68, 0xdd, 0xf7, 0xf6, 0xb6, 0x77, 0xf6, 0x25, 0x32, 0xc7, 0xd6, 0xa0, 0xfd, 0xe0, 0xfd,
-	0xc7, 0xbb, 0x8f, 0x1e, 0x3f, 0xec, 0xed, 0x6c, 0x3f, 0xde, 0xd9, 0x3b, 0xd8, 0xdb, 0x6d, 0x57,
-	0xd9, 0x22, 0x34, 0xb6, 0xef, 0x6f, 0x3f, 0xde, 0x7d, 0xf2, 0x78, 0x6f, 0xb7, 0x5d, 0x73, 0xff,
-	0xc1, 0x81, 0x75, 0x1a, 0xf5, 0x20, 0x2f, 0x20, 0x37, 0xa1, 0xd9, 0x8f, 0xa2, 0x09, 0x47, 0x7d,
-	0xae, 0x55, 0xb6, 0x09, 0x21, 0xf3, 0x0b, 0x05, 0x79, 0x12, 0xc5, 0x7d, 0x2e, 0xe5, 0x03, 0x08,
-	0x7a, 0x80, 0x08, 0x32, 0xbf, 0xdc, 0x5e, 0x41, 0x21, 0xc4, 0xa3, 0x29, 0x30, 0x41, 0xb2, 0x01,
-	0xf3, 0xc7, 0x31, 0xf7, 0xfb, 0xa7, 0x52, 0x32, 0x64, 0x89, 0x7d, 0x32, 0x73, 0xc2, 0xfb, 0xb8,
-	0xfa, 0x23, 0x3e, 0x20, 0x8e, 0xa9, 0x7b, 0xcb, 0x12, 0xdf, 0x91, 0x30, 0x6a, 0x06, 0xff, 0xd8,
-	0x0f, 0x07, 0x51, 0xc8, 0x07, 0xc4, 0x34, 0x75, 0x2f, 0x03, 0xdc, 0x43, 0xd8, 0xc8, 0xcf, 0x4f,
-	0xca, 0xd7, 0x5b, 0x86, 0x7c, 0x09, 0x6f, 0xb9, 0x7b, 0xf1, 0x6e, 0x1a, 0xb2, 0xf6, 0x2f, 0x0e,
-	0x54, 0xd1, 0xd8, 0x5e, 0x6c, 0x98, 0x4d, 0xff, 0x69, 0xce, 0xf2, 0x9f, 0x28, 0x9c, 0x80, 0xa7,
-	0x0c, 0xa1, 0x7e, 0x85, 0x89, 0x32, 0x90, 0xac, 0x3e, 0xe6, 0xfd, 0x33, 0x9a, 0xb1, 0xae, 0x47,
-	0x04, 0x05, 0x04, 0x5d, 0x51, 0xfa, 0x5a, 0x0a, 0x88, 0x2a, 0xab, 0x3a, 0xfa, 0x72, 0x21, 0xab,
-	0xa3, 0xef, 0x3a, 0xb0, 0x10, 0x84, 0xc7, 0xd1, 0x34, 0x1c, 0x90, 0x40, 0xd4, 0x3d, 0x55, 0xc4,
-	0xe5, 0x9b, 0x90, 0xa0, 0x06, 0x63, 0xc5, 0xfe, 0x19, 0xe0, 0x32, 0x3c, 0xaa, 0x24, 0xe4, 0x5c,
-	0xe8, 0x60, 0xc2, 0x5b, 0xb0, 0x62, 0x60, 0x72, 0x35, 0x5f, 0x85, 0xda, 0x04, 0x01, 0xb9, 0x94,
-	0x4a, 0x95, 0x93, 0x57, 0x22, 0x6a, 0xdc, 0x36, 0x2c, 0x3d, 0xe4, 0xe9, 0xa3, 0xf0, 0x24, 0x52,
-	0x2d, 0x7d, 0xa7, 0x0a, 0xcb, 0x1a, 0x92, 0x0d, 0xdd, 0x86, 0xe5, 0x60, 0xc0, 0xc3, 0x34, 0x48,
-	0x67, 0x3d, 0xeb, 0x44, 0x94, 0x87, 0xd1, 0x9b, 0xf3, 0x47, 0x81, 0x9f, 0x48, 0x7f, 0x41, 0x14,
-	0xd8, 0x16, 0xac, 0xa1, 0xa9, 0x51, 0xd6, 0x43, 0x6f, 0xb1, 0x38, 0x98, 0x95, 0xd6, 0xa1, 0x32,
-	0x40, 0x5c, 0x6a, 0x7b, 0xfd, 0x89, 0xf0, 0x6a, 0xca, 0xaa, 0x70, 0xd5, 0x44, 0x4b, 0x38, 0xe5,
-	0x9a, 0x30, 0x47, 0x1a, 0x28, 0x04, 0x85, 0xe6, 0x85, 0xaa, 0xca, 0x07, 0x85, 0x8c, 0xc0, 0x52,
-	0xbd, 0x10, 0x58, 0x42, 0x55, 0x36, 0x0b, 0xfb, 0x7c, 0xd0, 0x4b, 0xa3, 0x1e, 0xa9, 0x5c, 0xda,
-	0x9d, 0xba, 0x97, 0x87, 0x29, 0x04, 0xc6, 0x93, 0x34, 0xe4, 0x29, 0x69, 0xa5, 0xba, 0xa7, 0x8a,
-	0x28, 0x5d, 0x44, 0x22, 0x0c, 0x48, 0xc3, 0x93, 0x25, 0x74, 0x4b, 0xa7, 0x71, 0x90, 0x74, 0x5a,
-	0x84, 0xd2, 0x6f, 0xf6, 0x69, 0x58, 0x3f, 0xe6, 0x49, 0xda, 0x3b, 0xe5, 0xfe, 0x80, 0xc7, 0xb4,
-	0xfb, 0x22, 0x5e, 0x25, 0xac, 0x7d, 0x79, 0x25, 0xf6, 0x7d, 0xc6, 0xe3, 0x24, 0x88, 0x42, 0xb2,
-	0xf3, 0x0d, 0x4f, 0x15, 0xb1, 0x3d, 0x5c, 0x10, 0x6d, 0x43, 0xf5, 0xaa, 0x2e, 0xd3, 0x62, 0x94,
-	0x57, 0xba, 0xdf, 0x22, 0x9f, 0x5b, 0xc7, 0xdf, 0xde, 0x27, 0x87, 0x81, 0xbd, 0x04, 0x0d, 0xb1,
-	0x32, 0xc9, 0xa9, 0x2f, 0x8f, 0x01, 0x75, 0x02, 0x8e, 0x4e, 0x7d, 0xd4, 0x32, 0xd6, 0x62, 0x8b,
-	0x80, 0x66, 0x93, 0xb0, 0x7d, 0xb1, 0xd6, 0xaf, 0xc1, 0x92, 0x8a, 0xec, 0x25, 0xbd, 0x11, 0x3f,
-	0x49, 0xd5, 0x31, 0x3d, 0x9c, 0x8e, 0xb1, 0xbb, 0xe4, 0x80, 0x9f, 0xa4, 0xee, 0x63, 0x58, 0x91,
-	0x92, 0xff, 0x64, 0xc2, 0x55, 0xd7, 0x9f, 0x2d, 0xb3, 0xa0, 0xcd, 0xad, 0x55, 0x5b, 0x55, 0x50,
-	0xac, 0x21, 0x67, 0x56, 0x5d, 0x0f, 0x98, 0xa9, 0x49, 0x64, 0x83, 0xd2, 0x8c, 0xa9, 0x60, 0x80,
-	0x9c, 0x8e, 0x85, 0xe1, 0xaa, 0x26, 0xd3, 0x7e, 0x1f, 0xf5, 0x87, 0xd0, 0xaa, 0xaa, 0xe8, 0x7e,
-	0xdf, 0x81, 0x55, 0x6a, 0x4d, 0xf9, 0x00, 0xfa, 0x04, 0xf9, 0xe2, 0xc3, 0x6c, 0xf5, 0xcd, 0x00,
-	0xc9, 0x1a, 0xd4, 0x4c, 0xfd, 0x2d, 0x0a, 0x3f, 0xf9, 0x99, 0xb8, 0x5a, 0x38, 0x13, 0xff, 0xbd,
-	0x03, 0x2b, 0x42, 0x85, 0xa6, 0x7e, 0x3a, 0x4d, 0xe4, 0xf4, 0xff, 0x0f, 0x2c, 0x0a, 0x5b, 0x28,
-	0x85, 0x50, 0x0e, 0x74, 0x4d, 0xeb, 0x0b, 0x42, 0x05, 0xf1, 0xfe, 0x15, 0xcf, 0x26, 0x66, 0x9f,
-	0x87, 0x96, 0x19, 0x9e, 0xa5, 0x31, 0x37, 0xb7, 0xae, 0xa9, 0x59, 0x16, 0x38, 0x67, 0xff, 0x8a,
-	0x67, 0x7d, 0xc0, 0xde, 0x25, 0x87, 0x26, 0xec, 0x51, 0xb3, 0x32, 0xc0, 0x75, 0xad, 0x44, 0xed,
-	0xeb, 0xcf, 0x0d, 0xf2, 0xfb, 0x75, 0x98, 0x17, 0x1e, 0xac, 0xfb, 0x10, 0x16, 0xad, 0x91, 0x5a,
-	0x67, 0xfd, 0x96, 0x38, 0xeb, 0x17, 0x42, 0x43, 0x95, 0x62, 0x68, 0xc8, 0xfd, 0xd3, 0x39, 0x60,
-	0xc8, 0x6d, 0xb9, 0xed, 0x44, 0x17, 0x3a, 0x1a, 0x58, 0x07, 0xa2, 0x96, 0x67, 0x42, 0xec, 0x0e,
-	0x30, 0xa3, 0xa8, 0xa2, 0x67, 0xc2, 0xda, 0x94, 0xd4, 0xa0, 0x5a, 0x94, 0xc6, 0x5a, 0x9a, 0x55,
-	0x79, 0xf4, 0x13, 0xfb, 0x56, 0x5a, 0x87, 0x06, 0x65, 0x32, 0x4d, 0x4e, 0xd1, 0xc5, 0x57, 0x47,
-	0x26, 0x55, 0xce, 0x33, 0xc8, 0xfc, 0xa5, 0x0c, 0xb2, 0x90, 0x67, 0x10, 0xd3, 0x69, 0xaf, 0x5b,
-	0x4e, 0x3b, 0x3a, 0x8b, 0x63, 0x74, 0x31, 0xd3, 0x51, 0xbf, 0x37, 0xc6, 0xde, 0xe5, 0x09, 0xc9,
-	0x02, 0xd9, 0x26, 0xb4, 0xa5, 0x7b, 0x91, 0x9d, 0x0c, 0x80, 0xd6, 0xb8, 0x80, 0xa3, 0xbe, 0xc6,
-	0x8f, 0x49, 0x03, 0xd0, 0x29, 0xa9, 0xe6, 0x65, 0x00, 0x9e, 0xa5, 0x12, 0x64, 0xb1, 0xde, 0x34,
-	0x94, 0xdc, 0xc2, 0x07, 0x74, 0x36, 0xaa, 0x7b, 0xc5, 0x0a, 0xf7, 0x47, 0x0e, 0xb4, 0x71, 0xcf,
-	0x2c, 0xbe, 0x7e, 0x07, 0x48, 0xac, 0x5e, 0x90, 0xad, 0x2d, 0xda, 0x9f, 0x9d, 0xab, 0xdf, 0x86,
-	0x06, 0x35, 0x18, 0x4d, 0x78, 0x28, 0x99, 0xba, 0x63, 0x33, 0x75, 0xa6, 0xd1, 0xf6, 0xaf, 0x78,
-	0x19, 0xb1, 0xc1, 0xd2, 0x7f, 0xe7, 0x40, 0x53, 0x0e, 0xf3, 0xa7, 0x8e, 0x1c, 0x74, 0xa1, 0x8e,
-	0xdc, 0x6d, 0x1c, 0xcf, 0x75, 0x19, 0xed, 0xd9, 0xd8, 0x4f, 0xa7, 0x31, 0x1a, 0x70, 0x2b, 0x6a,
-	0x90, 0x87, 0xd1, 0x1a, 0x93, 0xf2, 0x4e, 0x7a, 0x69, 0x30, 0xea, 0xa9, 0x5a, 0x79, 0xb3, 0x52,
-	0x56, 0x85, 0x3a, 0x2c, 0x49, 0xfd, 0x21, 0x97, 0x86, 0x56, 0x14, 0xdc, 0x0e, 0x6c, 0xc8, 0x09,
-	0xe5, 0x7c, 0x5b, 0xf7, 0x2f, 0x5b, 0x70, 0xb5, 0x50, 0xa5, 0xaf, 0x26, 0xe5, 0x71, 0x78, 0x14,
-	0x8c, 0x8f, 0x23, 0x7d, 0x30, 0x70, 0xcc, 0x93, 0xb2, 0x55, 0xc5, 0x86, 0xb0, 0xae, 0x3c, 0x0a,
-	0x5c, 0xd3, 0xcc, 0xd2, 0x55, 0xc8, 0x15, 0x7a, 0xd3, 0xe6, 0x81, 0x7c, 0x87, 0x0a, 0x37, 0xb5,
-	0x40, 0x79, 0x7b, 0xec, 0x14, 0x3a, 0xda, 0x75, 0x91, 0xe6, 0xc2, 0x70, 0x6f, 0xb0, 0xaf, 0x37,
-	0x2e, 0xe9, 0xcb, 0x72, 0x85, 0xbd, 0x0b, 0x5b, 0x63, 0x33, 0xb8, 0xa1, 0xea, 0xc8, 0x1e, 0x14,
-	0xfb, 0xab, 0xbe, 0xd0, 0xdc, 0xc8, 0xc9, 0xb7, 0x3b, 0xbd, 0xa4, 0x61, 0xf6, 0x0d, 0xd8, 0x38,
-	0xf7, 0x83, 0x54, 0x0d, 0xcb, 0x70, 0x1c, 0x6a, 0xd4, 0xe5, 0xd6, 0x25, 0x5d, 0x7e, 0x20, 0x3e,
-	0xb6, 0x8c, 0xe4, 0x05, 0x2d, 0x76, 0xff, 0xc6, 0x81, 0x25, 0xbb, 0x1d, 0x64, 0x53, 0xa9, 0x3c,
-	0x94, 0x12, 0x55, 0xee, 0x67, 0x0e, 0x2e, 0x9e, 0xad, 0x2b, 0x65, 0x67, 0x6b, 0xf3, 0x44, 0x3b,
-	0x77, 0x59, 0xd8, 0xa9, 0xfa, 0x62, 0x61, 0xa7, 0x5a, 0x59, 0xd8, 0xa9, 0xfb, 0xef, 0x0e, 0xb0,
-	0x22, 0x2f, 0xb1, 0x87, 0xe2, 0x70, 0x1f, 0xf2, 0x91, 0xd4, 0x49, 0xff, 0xfb, 0xc5, 0xf8, 0x51,
-	0xad, 0x9d, 0xfa, 0x1a, 0x05, 0xc3, 0x54, 0x3a, 0xa6, 0xbb, 0xb5, 0xe8, 0x95, 0x55, 0xe5, 0x02,
-	0x61, 0xd5, 0xcb, 0x03, 0x61, 0xb5, 0xcb, 0x03, 0x61, 0xf3, 0xf9, 0x40, 0x58, 0xf7, 0xd7, 0x1d,
-	0x58, 0x2d, 0xd9, 0xf4, 0x9f, 0xdf, 0xc4, 0x71, 0x9b, 0x2c, 0x5d, 0x50, 0x91, 0xdb, 0x64, 0x82,
-	0xdd, 0x5f, 0x82, 0x45, 0x8b, 0xd1, 0x7f, 0x7e, 0xfd, 0xe7, 0x3d, 0x46, 0xc1, 0x67, 0x16, 0xd6,
-	0xfd, 0xd7, 0x0a, 0xb0, 0xa2, 0xb0, 0xfd, 0x8f, 0x8e, 0xa1, 0xb8, 0x4e, 0x73, 0x25, 0xeb, 0xf4,
-	0xdf, 0x6a, 0x07, 0xde, 0x80, 0x15, 0x99, 0xc7, 0x60, 0x84, 0x74, 0x04, 0xc7, 0x14, 0x2b, 0xd0,
-	0x67, 0xb6, 0xa3, 0x90, 0x75, 0xeb, 0xfe, 0xdb, 0x30, 0x86, 0xb9, 0x60, 0xa4, 0xbb, 0x01, 0x6b,
-	0x22, 0x2f, 0xe2, 0xbe, 0x68, 0x4a, 0xd9, 0x95, 0x3f, 0x74, 0x60, 0x3d, 0x57, 0x91, 0xdd, 0xd6,
-	0x0a, 0xd3, 0x61, 0xdb, 0x13, 0x1b, 0xc4, 0xf1, 0x6b, 0x37, 0x23, 0xc7, 0x6d, 0xc5, 0x0a, 0x5c,
-	0x1f, 0xc3, 0x2d, 0xc9, 0xad, 0x7a, 0x59, 0x95, 0x7b, 0x55, 0x64, 0x6f, 0x84, 0x7c, 0x94, 0x1b,
-	0xf8, 0x89, 0xc8, 0xb7, 0x30, 0x2b, 0xb2, 0xab, 0x20, 0x7b, 0xc8, 0xaa, 0x88, 0x1e, 0xa5, 0x65,
-	0xa6, 0xec, 0xf1, 0x96, 0xd6, 0xb9, 0x3f, 0x74, 0x80, 0x7d, 0x69, 0xca, 0xe3, 0x19, 0xdd, 0xda,
-	0xea, 0x58, 0xd3, 0xd5, 0x7c, 0x24, 0x65, 0x7e, 0x32, 0x3d, 0xfe, 0x22, 0x9f, 0xa9, 0xbb, 0xfd,
-	0x4a, 0x76, 0xb7, 0xff, 0x32, 0x00, 0x1e, 0xe5, 0xf4, 0x55, 0x30, 0x79, 0x72, 0xe1, 0x74, 0x2c,
-	0x1a, 0x2c, 0xbd, 0x7e, 0xaf, 0x5e, 0x7e, 0xfd, 0x5e, 0xbb, 0xec, 0xfa, 0xfd, 0x5d, 0x58, 0xb5,
-	0xc6, 0xad, 0xb7, 0x55, 0x5d, 0x4a, 0x3b, 0xcf, 0xb9, 0x94, 0xfe, 0xcd, 0x0a, 0xcc, 0xed, 0x47,
-	0x13, 0x33, 0xce, 0xea, 0xd8, 0x71, 0x56, 0x69, 0x4b, 0x7a, 0xda, 0x54, 0x48, 0x15, 0x63, 0x81,
-	0x6c, 0x13, 0x96, 0xfc, 0x71, 0x8a, 0x07, 0xff, 0x93, 0x28, 0x3e, 0xf7, 0xe3, 0x81, 0xd8, 0xeb,
-	0xfb, 0x95, 0x8e, 0xe3, 0xe5, 0x6a, 0xd8, 0x1a, 0xcc, 0x69, 0xa5, 0x4b, 0x04, 0x58, 0x44, 0xc7,
-	0x8d, 0xee, 0x68, 0x66, 0x32, 0x66, 0x21, 0x4b, 0xc8, 0x4a, 0xf6, 0xf7, 0xc2, 0xed, 0x16, 0xa2,
-	0x53, 0x56, 0x85, 0x76, 0x0d, 0x97, 0x8f, 0xc8, 0x64, 0xb0, 0x49, 0x95, 0xcd, 0xc0, 0x58, 0xdd,
-	0xbe, 0xb1, 0xfa, 0x67, 0x07, 0x6a, 0xb4, 0x36, 0xa8, 0x06, 0x04, 0xef, 0xeb, 0x50, 0x2b, 0xad,
-	0xc9, 0xa2, 0x97, 0x87, 0x99, 0x6b, 0x65, 0xc7, 0x54, 0xf4, 0x84, 0xcc, 0x0c, 0x99, 0x9b, 0xd0,
-	0x10, 0x25, 0x9d, 0x09, 0x42, 0x24, 0x19, 0xc8, 0x6e, 0x40, 0xf5, 0x34, 0x9a, 0x28, 0xbf, 0x05,
-	0xd4, 0x4d, 0x43, 0x34, 0xf1, 0x08, 0xcf, 0xc6, 0x83, 0xed, 0x89, 0x69, 0x09, 0x6b, 0x94, 0x87,
-	0xd1, 0x1e, 0xeb, 0x66, 0xcd, 0x65, 0xca, 0xa1, 0xee, 0x26, 0x2c, 0x3f, 0x8e, 0x06, 0xdc, 0x88,
-	0x77, 0x5d, 0xc8, 0xe7, 0xee, 0x2f, 0x3b, 0x50, 0x57, 0xc4, 0xec, 0x36, 0x54, 0xd1, 0xc9, 0xc8,
-	0x1d, 0x21, 0xf4, 0x0d, 0x23, 0xd2, 0x79, 0x44, 0x81, 0x5a, 0x99, 0xe2, 0x1a, 0x99, 0xc3, 0xa9,
-	0xa2, 0x1a, 0x99, 0x3f, 0xa5, 0x87, 0x9b, 0x73, 0x43, 0x72, 0xa8, 0xfb, 0x03, 0x07, 0x16, 0xad,
-	0x3e, 0xf0, 0x10, 0x3a, 0xf2, 0x93, 0x54, 0xde, 0xda, 0xc8, 0xed, 0x31, 0x21, 0x73, 0xa3, 0x2b,
-	0x76, 0x04, 0x54, 0xc7, 0xe6, 0xe6, 0xcc, 0xd8, 0xdc, 0x3d, 0x68, 0x64, 0x39, 0x4c, 0x55, 0x4b,
-	0xdb, 0x62, 0x8f, 0xea, 0xee, 0x34, 0x23, 0xc2, 0x76, 0xfa, 0xd1, 0x28, 0x8a, 0xe5, 0x75, 0x81,
-	0x28, 0xb8, 0xef, 0x42, 0xd3, 0xa0, 0xc7, 0x61, 0x84, 0x3c, 0x3d, 0x8f, 0xe2, 0x67, 0x2a, 0x10,
-	0x2b, 0x8b, 0x3a, 0x0d, 0xa0, 0x92, 0xa5, 0x01, 0xb8, 0x7f, 0xed, 0xc0, 0x22, 0xf2, 0x60, 0x10,
-	0x0e, 0x0f, 0xa3, 0x51, 0xd0, 0x9f, 0xd1, 0xde, 0x2b, 0x76, 0x93, 0x3a, 0x43, 0xf1, 0xa2, 0x0d,
-	0x23, 0xd7, 0xab, 0x33, 0xa8, 0x14, 0x51, 0x5d, 0x46, 0x19, 0x46, 0x09, 0x38, 0xf6, 0x13, 0x29,
-	0x16, 0xd2, 0xfc, 0x59, 0x20, 0x4a, 0x1a, 0x02, 0xb1, 0x9f, 0xf2, 0xde, 0x38, 0x18, 0x8d, 0x02,
-	0x41, 0x2b, 0x9c, 0xa3, 0xb2, 0x2a, 0xec, 0x73, 0x10, 0x24, 0xfe, 0x71, 0x16, 0x02, 0xd7, 0x65,
-	0xf7, 0xcf, 0x2b, 0xd0, 0x94, 0x8a, 0x7b, 0x6f, 0x30, 0xe4, 0xf2, 0xbe, 0x86, 0xdc, 0x4f, 0xad,
-	0x64, 0x0c, 0x44, 0xd5, 0x5b, 0x0e, 0xab, 0x81, 0xe4, 0xb7, 0x7c, 0xae, 0xb8, 0xe5, 0xd7, 0xa1,
-	0x81, 0xac, 0xf7, 0x26, 0x79, 0xc6, 0xe2, 0xae, 0x27, 0x03, 0x54, 0xed, 0x16, 0xd5, 0xd6, 0xb2,
-	0x5a, 0x02, 0x9e, 0x7b, 0xbb, 0xf3, 0x36, 0xb4, 0x64, 0x33, 0xb4, 0x27, 0xa4, 0x53, 0x32, 0xe6,
-	0xb7, 0xf6, 0xcb, 0xb3, 0x28, 0xd5, 0x97, 0x5b, 0xea, 0xcb, 0xfa, 0x65, 0x5f, 0x2a, 0x4a, 0xf7,
-	0xa1, 0xbe, 0x34, 0x7b, 0x18, 0xfb, 0x93, 0x53, 0x25, 0xa5, 0xf7, 0x60, 0x35, 0x08, 0xfb, 0xa3,
-	0xe9, 0x80, 0xf7, 0xa6, 0xa1, 0x1f, 0x86, 0xd1, 0x34, 0xec, 0x73, 0x95, 0x33, 0x50, 0x56, 0xe5,
-	0x0e, 0x74, 0xd2, 0x11, 0x35, 0xc4, 0x36, 0xa1, 0x86, 0x1d, 0x29, 0xab, 0x50, 0x2e, 0xc2, 0x82,
-	0x84, 0xdd, 0x86, 0x1a, 0x1f, 0x0c, 0xb9, 0x3a, 0x2d, 0x32, 0xfb, 0xdc, 0x8e, 0xbb, 0xea, 0x09,
-	0x02, 0x54, 0x28, 0x88, 0xe6, 0x14, 0x8a, 0x6d, 0x51, 0xe6, 0xb1, 0xf8, 0x68, 0xe0, 0xae, 0x01,
-	0x7b, 0x2c, 0x64, 0xc0, 0x8c, 0xb7, 0xff, 0xda, 0x1c, 0x34, 0x0d, 0x18, 0x75, 0xc3, 0x10, 0x07,
-	0xdc, 0x1b, 0x04, 0xfe, 0x98, 0xa7, 0x3c, 0x96, 0x7c, 0x9f, 0x43, 0x91, 0xce, 0x3f, 0x1b, 0xf6,
-	0xa2, 0x69, 0xda, 0x1b, 0xf0, 0x61, 0xcc, 0x85, 0x91, 0x47, 0xa3, 0x63, 0xa1, 0x48, 0x37, 0xf6,
-	0x3f, 0x34, 0xe9, 0x04, 0x07, 0xe5, 0x50, 0x15, 0x3d, 0x17, 0x6b, 0x54, 0xcd, 0xa2, 0xe7, 0x62,
-	0x45, 0xf2, 0x5a, 0xad, 0x56, 0xa2, 0xd5, 0xde, 0x82, 0x0d, 0xa1, 0xbf, 0xa4, 0xa4, 0xf7, 0x72,
-	0x8c, 0x75, 0x41, 0x2d, 0xdb, 0x84, 0x36, 0x8e, 0x59, 0x89, 0x44, 0x12, 0x7c, 0x4b, 0x44, 0xa6,
-	0x1c, 0xaf, 0x80, 0x23, 0x2d, 0x85, 0x88, 0x4c, 0x5a, 0x71, 0x9b, 0x58, 0xc0, 0x89, 0xd6, 0xff,
-	0xd0, 0xa6, 0x6d, 0x48, 0xda, 0x1c, 0xee, 0x2e, 0x42, 0xf3, 0x28, 0x8d, 0x26, 0x6a, 0x53, 0x96,
-	0xa0, 0x25, 0x8a, 0x32, 0x77, 0xe3, 0x25, 0xb8, 0x46, 0x5c, 0xf4, 0x34, 0x9a, 0x44, 0xa3, 0x68,
-	0x38, 0x3b, 0x9a, 0x1e, 0x27, 0xfd, 0x38, 0x98, 0xe0, 0xc9, 0xca, 0xfd, 0x5b, 0x07, 0x56, 0xad,
-	0x5a, 0x19, 0x7e, 0xfa, 0xb4, 0x10, 0x02, 0x7d, 0xe9, 0x2e, 0x18, 0x6f, 0xc5, 0x50, 0xae, 0x82,
-	0x50, 0x04, 0x11, 0xdf, 0x97, 0xf7, 0xf0, 0xdb, 0xb0, 0xac, 0x46, 0xa6, 0x3e, 0x14, 0x5c, 0xd8,
-	0x29, 0x72, 0xa1, 0xfc, 0x7e, 0x49, 0x7e, 0xa0, 0x9a, 0xf8, 0xbf, 0xf2, 0x56, 0x76, 0x40, 0x73,
-	0x54, 0x71, 0x08, 0x7d, 0x93, 0x66, 0x9e, 0x46, 0xd4, 0x08, 0xfa, 0x1a, 0x4c, 0xdc, 0xdf, 0x76,
-	0x00, 0xb2, 0xd1, 0xd1, 0x5d, 0x9e, 0x36, 0x10, 0x22, 0x19, 0xdc, 0x30, 0x06, 0xaf, 0x42, 0x4b,
-	0xdf, 0x01, 0x65, 0x36, 0xa7, 0xa9, 0x30, 0x74, 0x18, 0x6f, 0xc1, 0xf2, 0x70, 0x14, 0x1d, 0x93,
-	0xc1, 0xa6, 0x64, 0xa0, 0x44, 0x66, 0xb0, 0x2c, 0x09, 0xf8, 0x81, 0x44, 0x33, 0x03, 0x55, 0x35,
-	0x0c, 0x94, 0xfb, 0xed, 0x8a, 0xbe, 0x03, 0xc8, 0xe6, 0x7c, 0xa1, 0x94, 0xb1, 0xad, 0x82, 0x3a,
-	0xbd, 0x20, 0xe4, 0x4e, 0x11, 0xb7, 0xc3, 0x4b, 0x03, 0x02, 0xef, 0xc2, 0x52, 0x2c, 0xf4, 0x95,
-	0x52, 0x66, 0xd5, 0xe7, 0x28, 0xb3, 0xc5, 0xd8, 0xb2, 0x62, 0x9f, 0x84, 0xb6, 0x3f, 0x38, 0xe3,
-	0x71, 0x1a, 0xd0, 0x91, 0x8c, 0x5c, 0x08, 0xa1, 0x82, 0x97, 0x0d, 0x9c, 0x2c, 0xfb, 0x2d, 0x58,
-	0x96, 0x59, 0x43, 0x9a, 0x52, 0x66, 0xb3, 0x66, 0x30, 0x12, 0xba, 0x7f, 0xac, 0xae, 0x1b, 0xec,
-	0x3d, 0xbc, 0x78, 0x45, 0xcc, 0xd9, 0x55, 0x72, 0xb3, 0xfb, 0x84, 0x0c, 0xfd, 0x0f, 0xd4, 0xb9,
-	0x6f, 0xce, 0xb8, 0xc1, 0x1f, 0xc8, 0xab, 0x1a, 0x7b, 0x49, 0xab, 0x2f, 0xb2, 0xa4, 0xee, 0x8f,
-	0x1c, 0x58, 0xd8, 0x8f, 0x26, 0xfb, 0x32, 0x97, 0x81, 0x04, 0x41, 0xe7, 0xdd, 0xa9, 0xe2, 0x73,
-	0xb2, 0x1c, 0x4a, 0x2d, 0xf7, 0x62, 0xde, 0x72, 0xff, 0x3f, 0x78, 0x89, 0xa2, 0x0e, 0x71, 0x34,
-	0x89, 0x62, 0x14, 0x46, 0x7f, 0x24, 0xcc, 0x74, 0x14, 0xa6, 0xa7, 0x4a, 0x8d, 0x3d, 0x8f, 0x84,
-	0x8e, 0x77, 0x78, 0x2c, 0x11, 0x4e, 0xb7, 0xf4, 0x34, 0x84, 0x76, 0x2b, 0x56, 0xb8, 0x9f, 0x85,
-	0x06, 0xb9, 0xca, 0x34, 0xad, 0x37, 0xa0, 0x71, 0x1a, 0x4d, 0x7a, 0xa7, 0x41, 0x98, 0x2a, 0xe1,
-	0x5e, 0xca, 0x7c, 0xd8, 0x7d, 0x5a, 0x10, 0x4d, 0xe0, 0xfe, 0x7e, 0x0d, 0x16, 0x1e, 0x85, 0x67,
-	0x51, 0xd0, 0xa7, 0x9b, 0x89, 0x31, 0x1f, 0x47, 0x2a, 0x0b, 0x11, 0x7f, 0xe3, 0x52, 0x50, 0xb6,
-	0xce, 0x24, 0x95, 0x57, 0x0b, 0xaa, 0x88, 0x0e, 0x42, 0x9c, 0x65, 0x0a, 0x0b, 0xd1, 0x31, 0x10,
-	0x3c, 0x40, 0xc4, 0x66, 0x52, 0xb5, 0x2c, 0x65, 0x69, 0x9c, 0x35, 0x23, 0x8d, 0x93, 0xee, 0xb1,
-	0x44, 0xde, 0x85, 0xbc, 0x98, 0x57, 0x45, 0x3a, 0xf0, 0xc4, 0x5c, 0x44, 0x8b, 0xc8, 0xd5, 0x58,
-	0x90, 0x07, 0x1e, 0x13, 0x44, 0x77, 0x44, 0x7c, 0x20, 0x68, 0x84, 0xf2, 0x35, 0x21, 0x74, 0xdd,
-	0xf2, 0x79, 0xd9, 0x0d, 0xc1, 0xf3, 0x39, 0x18, 0x35, 0xf4, 0x80, 0x6b, 0x45, 0x2a, 0xe6, 0x00,
-	0x22, 0x13, 0x3a, 0x8f, 0x1b, 0xc7, 0x24, 0x91, 0x50, 0xa5, 0x8e, 0x49, 0xc8, 0x28, 0xfe, 0x68,
-	0x74, 0xec, 0xf7, 0x9f, 0x51, 0xda, 0x3d, 0xdd, 0x11, 0x34, 0x3c, 0x1b, 0xa4, 0xcc, 0x89, 0x6c,
-	0x37, 0xe9, 0xfe, 0xb4, 0xea, 0x99, 0x10, 0xdb, 0x82, 0x26, 0x1d, 0x0d, 0xe5, 0x7e, 0x2e, 0xd1,
-	0x7e, 0xb6, 0xcd, 0xb3, 0x23, 0xed, 0xa8, 0x49, 0x64, 0xde, 0x96, 0x2c, 0xdb, 0xb7, 0x25, 0x42,
-	0x69, 0xca, 0x4b, 0xa6, 0x36, 0xf5, 0x96, 0x01, 0x68, 0x4d, 0xe5, 0x82, 0x09, 0x82, 0x15, 0x22,
-	0xb0, 0x30, 0x76, 0x03, 0xea, 0x78, 0x6c, 0x99, 0xf8, 0xc1, 0xa0, 0xc3, 0xf4, 0xe9, 0x49, 0x63,
-	0xd8, 0x86, 0xfa, 0x4d, 0x97, 0x41, 0xab, 0xb4, 0x2a, 0x16, 0x86, 0x6b, 0xa3, 0xcb, 0x24, 0x44,
-	0x6b, 0x62, 0x47, 0x2d, 0xd0, 0x4d, 0x81, 0x6d, 0x0f, 0x06, 0x92, 0x37, 0xf5, 0x31, 0x3a, 0xe3,
-	0x2a, 0xc7, 0xe2, 0xaa, 0x92, 0xdd, 0xad, 0x94, 0xef, 0xee, 0x73, 0xd7, 0xc0, 0xdd, 0x83, 0xe6,
-	0xa1, 0x91, 0x7a, 0x4e, 0x4c, 0xae, 0x92, 0xce, 0xa5, 0x60, 0x18, 0x88, 0x31, 0x9c, 0x8a, 0x39,
-	0x1c, 0xf7, 0x4f, 0x1c, 0x60, 0x07, 0x41, 0x92, 0xea, 0xe1, 0x8b, 0xbe, 0x5d, 0x68, 0xe9, 0x60,
-	0x47, 0x96, 0x4b, 0x66, 0x61, 0x48, 0x43, 0x43, 0xe9, 0x45, 0x27, 0x27, 0x09, 0x57, 0x99, 0x1f,
-	0x16, 0x86, 0x1c, 0x8a, 0x3e, 0x0e, 0xfa, 0x0b, 0x81, 0xe8, 0x21, 0x91, 0x19, 0x20, 0x05, 0x1c,
-	0xf5, 0x6c, 0xcc, 0xcf, 0x78, 0x9c, 0x68, 0xd1, 0xd2, 0x65, 0x9d, 0xf2, 0x96, 0x5f, 0xe5, 0x4d,
-	0xa8, 0xeb, 0x76, 0x6d, 0x15, 0xa2, 0x28, 0x75, 0x3d, 0xaa, 0x2a, 0xf2, 0xfa, 0xad, 0x41, 0x0b,
-	0xb5, 0x59, 0xac, 0x60, 0x77, 0x80, 0x9d, 0x04, 0x71, 0x9e, 0x7c, 0x8e, 0xc8, 0x4b, 0x6a, 0xdc,
-	0x0f, 0x60, 0x55, 0x76, 0x69, 0x3a, 0x37, 0xf6, 0x26, 0x3a, 0x97, 0x31, 0x72, 0xa5, 0xc8, 0xc8,
-	0xee, 0x7f, 0x38, 0xb0, 0x20, 0x77, 0x9a, 0xb6, 0x25, 0xff, 0x06, 0xa1, 0xe1, 0x59, 0x18, 0xeb,
-	0x58, 0xd9, 0xe7, 0xc4, 0xf5, 0x52, 0x75, 0x15, 0x14, 0xd4, 0x5c, 0x99, 0x82, 0x62, 0x50, 0x9d,
-	0xf8, 0xe9, 0x29, 0x9d, 0x65, 0x1b, 0x1e, 0xfd, 0x66, 0x6d, 0x11, 0x79, 0x11, 0x8a, 0x90, 0xa2,
-	0x2e, 0x65, 0x8f, 0x30, 0x84, 0xbd, 0x2d, 0x3e, 0xc2, 0xb8, 0x0e, 0x0d, 0x1a, 0x40, 0x2f, 0x0b,
-	0xac, 0x64, 0x00, 0x72, 0xae, 0x28, 0x90, 0x84, 0xc9, 0xd4, 0xd2, 0x0c, 0x71, 0xd7, 0xc5, 0xce,
-	0xcb, 0x25, 0xd0, 0xf7, 0x5d, 0x32, 0xc5, 0x30, 0x83, 0x33, 0x8e, 0x90, 0x03, 0xc8, 0x73, 0x84,
-	0x24, 0xf5, 0x74, 0xbd, 0xdb, 0x85, 0xce, 0x2e, 0x1f, 0xf1, 0x94, 0x6f, 0x8f, 0x46, 0xf9, 0xf6,
-	0x5f, 0x82, 0x6b, 0x25, 0x75, 0xd2, 0x9f, 0xfd, 0x12, 0xac, 0x6f, 0x8b, 0x74, 0xac, 0x9f, 0x57,
-	0xce, 0x82, 0xdb, 0x81, 0x8d, 0x7c, 0x93, 0xb2, 0xb3, 0x07, 0xb0, 0xb2, 0xcb, 0x8f, 0xa7, 0xc3,
-	0x03, 0x7e, 0x96, 0x75, 0xc4, 0xa0, 0x9a, 0x9c, 0x46, 0xe7, 0x52, 0x30, 0xe9, 0x37, 0x7b, 0x19,
-	0x60, 0x84, 0x34, 0xbd, 0x64, 0xc2, 0xfb, 0x2a, 0x85, 0x9c, 0x90, 0xa3, 0x09, 0xef, 0xbb, 0x6f,
-	0x01, 0x33, 0xdb, 0x91, 0xeb, 0x85, 0xf6, 0x68, 0x7a, 0xdc, 0x4b, 0x66, 0x49, 0xca, 0xc7, 0x2a,
-	0x37, 0xde, 0x84, 0xdc, 0x5b, 0xd0, 0x3a, 0xf4, 0x67, 0x1e, 0xff, 0xa6, 0x7c, 0xb5, 0x72, 0x15,
-	0x16, 0x26, 0xfe, 0x0c, 0xd5, 0x94, 0x8e, 0xf8, 0x50, 0xb5, 0xfb, 0x6f, 0x15, 0x98, 0x17, 0x94,
-	0xd8, 0xea, 0x80, 0x27, 0x69, 0x10, 0x8a, 0xdb, 0x5f, 0xd9, 0xaa, 0x01, 0x15, 0x58, 0xb9, 0x52,
-	0xc2, 0xca, 0xf2, 0xd4, 0xa4, 0xd2, 0x71, 0x25, 0xbf, 0x5a, 0x18, 0x32, 0x57, 0x96, 0xd7, 0x23,
-	0x42, 0x0e, 0x19, 0x90, 0x0b, 0x0e, 0x66, 0x56, 0x4f, 0x8c, 0x4f, 0x49, 0xa9, 0xe4, 0x5c, 0x13,
-	0x2a, 0xb5, 0xad, 0x0b, 0x82, 0xc1, 0x0b, 0xb6, 0xb5, 0x60, 0x43, 0xeb, 0x2f, 0x60, 0x43, 0xc5,
-	0x51, 0xea, 0x79, 0x36, 0x14, 0x5e, 0xc0, 0x86, 0xba, 0x0c, 0xda, 0x0f, 0x38, 0xf7, 0x38, 0x7a,
-	0x67, 0x8a, 0x77, 0xbf, 0xeb, 0x40, 0x5b, 0x72, 0x91, 0xae, 0x63, 0xaf, 0x5a, 0x5e, 0x68, 0x69,
-	0xd2, 0xec, 0x6b, 0xb0, 0x48, 0xbe, 0xa1, 0x8e, 0x82, 0xca, 0x90, 0xad, 0x05, 0xe2, 0x3c, 0xd4,
-	0x55, 0xd5, 0x38, 0x18, 0xc9, 0x4d, 0x31, 0x21, 0x15, 0x48, 0x8d, 0x7d, 0x99, 0x44, 0xe3, 0x78,
-	0xba, 0xec, 0xfe, 0x85, 0x03, 0x2b, 0xc6, 0x80, 0x25, 0x17, 0xbe, 0x0b, 0x4a, 0x1a, 0x44, 0x48,
-	0x54, 0x48, 0xee, 0x55, 0x5b, 0x6c, 0xb2, 0xcf, 0x2c, 0x62, 0xda, 0x4c, 0x7f, 0x46, 0x03, 0x4c,
-	0xa6, 0x63, 0xa9, 0x44, 0x4d, 0x08, 0x19, 0xe9, 0x9c, 0xf3, 0x67, 0x9a, 0x44, 0xa8, 0x71, 0x0b,
-	0xa3, 0x04, 0x0d, 0xf4, 0x69, 0x35, 0x91, 0xb0, 0x67, 0x36, 0xe8, 0xfe, 0xd8, 0x81, 0x55, 0x71,
-	0x38, 0x91, 0x47, 0x3f, 0xfd, 0xa2, 0x61, 0x5e, 0x9c, 0xc6, 0x84, 0x44, 0xee, 0x5f, 0xf1, 0x64,
-	0x99, 0x7d, 0xe6, 0x05, 0x0f, 0x54, 0x3a, 0x31, 0xe7, 0x82, 0xbd, 0x98, 0x2b, 0xdb, 0x8b, 0xe7,
-	0xac, 0x74, 0x59, 0x08, 0xb0, 0x56, 0x1a, 0x02, 0xbc, 0xbf, 0x00, 0xb5, 0xa4, 0x1f, 0x4d, 0xb8,
-	0xbb, 0x01, 0x6b, 0xf6, 0xe4, 0xa4, 0x0a, 0xfa, 0x9e, 0x03, 0x9d, 0x07, 0x22, 0x54, 0x1e, 0x84,
-	0xc3, 0xfd, 0x20, 0x49, 0xa3, 0x58, 0x3f, 0xd3, 0xba, 0x01, 0x90, 0xa4, 0x7e, 0x9c, 0x8a, 0x74,
-	0x4b, 0x19, 0xa0, 0xcb, 0x10, 0x1c, 0x23, 0x0f, 0x07, 0xa2, 0x56, 0xec, 0x8d, 0x2e, 0x17, 0x7c,
-	0x08, 0x79, 0x7c, 0xb2, 0x2c, 0xf1, 0xeb, 0x22, 0xd3, 0x0d, 0x7d, 0x05, 0x7e, 0x46, 0x7a, 0x5d,
-	0x9c, 0x4b, 0x72, 0xa8, 0xfb, 0x67, 0x0e, 0x2c, 0x67, 0x83, 0xdc, 0x43, 0xd0, 0xd6, 0x0e, 0xd2,
-	0xfc, 0x66, 0xda, 0x41, 0x85, 0x0e, 0x03, 0xb4, 0xc7, 0x72, 0x6c, 0x06, 0x42, 0x12, 0x2b, 0x4b,
-	0xd1, 0x54, 0x39, 0x38, 0x26, 0x24, 0xb2, 0x46, 0xd0, 0x13, 0x90, 0x5e, 0x8d, 0x2c, 0x51, 0xb6,
-	0xec, 0x38, 0xa5, 0xaf, 0xe6, 0xc5, 0xc1, 0x4c, 0x16, 0x95, 0x29, 0x5d, 0x20, 0x14, 0x7f, 0xba,
-	0xdf, 0x71, 0xe0, 0x5a, 0xc9, 0xe2, 0x4a, 0xc9, 0xd8, 0x85, 0x95, 0x13, 0x5d, 0xa9, 0x16, 0x40,
-	0x88, 0xc7, 0x86, 0xba, 0xdb, 0xb1, 0x27, 0xed, 0x15, 0x3f, 0xd0, 0xbe, 0x8f, 0x58, 0x52, 0x2b,
-	0x79, 0xab, 0x58, 0xb1, 0xf5, 0x3b, 0x73, 0xb0, 0x24, 0xee, 0xfc, 0xc4, 0x83, 0x69, 0x1e, 0xb3,
-	0xf7, 0x60, 0x41, 0x3e, 0x78, 0x67, 0xeb, 0xb2, 0x5b, 0xfb, 0x89, 0x7d, 0x77, 0x23, 0x0f, 0x4b,
-	0xde, 0x59, 0xfd, 0xd5, 0x1f, 0xfd, 0xd3, 0xef, 0x56, 0x16, 0x59, 0xf3, 0xee, 0xd9, 0x9b, 0x77,
-	0x87, 0x3c, 0x4c, 0xb0, 0x8d, 0x5f, 0x00, 0xc8, 0x9e, 0x82, 0xb3, 0x8e, 0xf6, 0xd9, 0x72, 0x6f,
-	0xdc, 0xbb, 0xd7, 0x4a, 0x6a, 0x64, 0xbb, 0xd7, 0xa8, 0xdd, 0x55, 0x77, 0x09, 0xdb, 0x0d, 0xc2,
-	0x20, 0x15, 0xef, 0xc2, 0xdf, 0x71, 0x36, 0xd9, 0x00, 0x5a, 0xe6, 0x4b, 0x6f, 0xa6, 0x42, 0x37,
-	0x25, 0xef, 0xcc, 0xbb, 0x2f, 0x95, 0xd6, 0xa9, 0xb8, 0x15, 0xf5, 0xb1, 0xee, 0xb6, 0xb1, 0x8f,
-	0x29, 0x51, 0x64, 0xbd, 0x8c, 0x60, 0xc9, 0x7e, 0xd0, 0xcd, 0xae, 0x1b, 0x62, 0x5d, 0x78, 0x4e,
-	0xde, 0x7d, 0xf9, 0x82, 0x5a, 0xd9, 0xd7, 0xcb, 0xd4, 0xd7, 0x55, 0x97, 0x61, 0x5f, 0x7d, 0xa2,
-	0x51, 0xcf, 0xc9, 0xdf, 0x71, 0x36, 0xb7, 0x7e, 0x7c, 0x03, 0x1a, 0x3a, 0xd8, 0xca, 0xbe, 0x01,
-	0x8b, 0xd6, 0xa5, 0x2c, 0x53, 0xd3, 0x28, 0xbb, 0xc3, 0xed, 0x5e, 0x2f, 0xaf, 0x94, 0x1d, 0xdf,
-	0xa0, 0x8e, 0x3b, 0x6c, 0x03, 0x3b, 0x96, 0xb7, 0x9a, 0x77, 0xe9, 0x2a, 0x5a, 0xe4, 0xe2, 0x3e,
-	0x13, 0xf3, 0xcc, 0x2e, 0x52, 0xad, 0x79, 0x16, 0x2e, 0x5e, 0xad, 0x79, 0x16, 0x6f, 0x5f, 0xdd,
-	0xeb, 0xd4, 0xdd, 0x06, 0x5b, 0x33, 0xbb, 0xd3, 0x41, 0x50, 0x4e, 0xd9, 0xd3, 0xe6, 0x7b, 0x6f,
-	0xf6, 0xb2, 0x66, 0xac, 0xb2, 0x77, 0xe0, 0x9a, 0x45, 0x8a, 0x8f, 0xc1, 0xdd, 0x0e, 0x75, 0xc5,
-	0x18, 0x6d, 0x9f, 0xf9, 0xdc, 0x9b, 0x7d, 0x0d, 0x1a, 0xfa, 0x71, 0x23, 0xbb, 0x6a, 0xbc, 0x28,
-	0x35, 0x5f, 0x5c, 0x76, 0x3b, 0xc5, 0x8a, 0x32, 0xc6, 0x30, 0x5b, 0x46, 0xc6, 0x38, 0x80, 0x75,
-	0x79, 0x06, 0x38, 0xe6, 0x3f, 0xc9, 0x4c, 0x4a, 0x5e, 0xa9, 0xdf, 0x73, 0xd8, 0xbb, 0x50, 0x57,
-	0x6f, 0x46, 0xd9, 0x46, 0xf9, 0xdb, 0xd7, 0xee, 0xd5, 0x02, 0x2e, 0xb5, 0xc7, 0x57, 0x00, 0xb2,
-	0xb7, 0x90, 0x5a, 0xce, 0x0a, 0xaf, 0x30, 0xf5, 0x22, 0x16, 0x1f, 0x4e, 0xba, 0x1b, 0x34, 0xd5,
-	0x36, 0x23, 0x39, 0x0b, 0xf9, 0xb9, 0x4a, 0xfb, 0xdf, 0x85, 0xa6, 0xf1, 0x1c, 0x92, 0xa9, 0x16,
-	0x8a, 0x4f, 0x29, 0xbb, 0xdd, 0xb2, 0x2a, 0x39, 0xc0, 0x2f, 0xc0, 0xa2, 0xf5, 0xae, 0x51, 0x33,
-	0x72, 0xd9, 0xab, 0x49, 0xcd, 0xc8, 0xe5, 0x4f, 0x21, 0xbf, 0x0a, 0x4d, 0xe3, 0x15, 0x22, 0x33,
-	0x92, 0x0d, 0x73, 0xef, 0x0f, 0xf5, 0x88, 0xca, 0x1e, 0x2d, 0xae, 0xd1, 0x7c, 0x97, 0xdc, 0x06,
-	0xce, 0x97, 0x72, 0xdf, 0x71, 0x4f, 0xbf, 0x01, 0x4b, 0xf6, 0xbb, 0x44, 0x2d, 0x04, 0xa5, 0x2f,
-	0x1c, 0xb5, 0x10, 0x5c, 0xf0, 0x98, 0x51, 0xf2, 0xcf, 0xe6, 0xaa, 0xee, 0xe4, 0xee, 0x47, 0xf2,
-	0x9e, 0xf1, 0x63, 0xf6, 0x25, 0x94, 0x74, 0xf9, 0x18, 0x81, 0x65, 0xaf, 0x31, 0xed, 0x27, 0x0b,
-	0x9a, 0x39, 0x0b, 0xef, 0x16, 0xdc, 0x15, 0x6a, 0xbc, 0xc9, 0xb2, 0x19, 0x08, 0xf5, 0x4d, 0x8f,
-	0x12, 0x0c, 0xf5, 0x6d, 0xbe, 0x5b, 0x30, 0xd4, 0xb7, 0xf5, 0x76, 0x21, 0xaf, 0xbe, 0xd3, 0x00,
-	0xdb, 0x08, 0x61, 0x39, 0x97, 0x6d, 0xa3, 0x79, 0xbb, 0x3c, 0x3d, 0xb1, 0x7b, 0xe3, 0xf9, 0x49,
-	0x3a, 0xb6, 0x56, 0x50, 0xda, 0xe0, 0xae, 0xca, 0x26, 0xfd, 0x45, 0x68, 0x99, 0xef, 0xc9, 0xb4,
-	0x42, 0x2f, 0x79, 0x05, 0xa7, 0x15, 0x7a, 0xd9, 0x03, 0x34, 0xb5, 0xb9, 0xac, 0x65, 0x76, 0x83,
-	0x9b, 0x6b, 0x3f, 0xa8, 0xc9, 0x34, 0x5c, 0xd9, 0x3b, 0xa2, 0x4c, 0xc3, 0x95, 0xbe, 0xc2, 0x51,
-	0x9b, 0xcb, 0x56, 0xad, 0xb9, 0x88, 0x90, 0x30, 0xfb, 0x2a, 0x2c, 0x1b, 0xa9, 0x6c, 0x47, 0xb3,
-	0xb0, 0xaf, 0x19, 0xb5, 0x98, 0x34, 0xdd, 0x2d, 0x73, 0x14, 0xdd, 0xab, 0xd4, 0xfe, 0x8a, 0x6b,
-	0x4d, 0x02, 0x99, 0x74, 0x07, 0x9a, 0x66, 0x9a, 0xdc, 0x73, 0xda, 0xbd, 0x6a, 0x54, 0x99, 0x39,
-	0xbf, 0xf7, 0x1c, 0xf6, 0x07, 0x0e, 0xb4, 0xac, 0xa4, 0x33, 0xeb, 0xe2, 0x23, 0xd7, 0x4e, 0xc7,
-	0xac, 0x33, 0x1b, 0x72, 0x3d, 0x1a, 0xe4, 0xc1, 0xe6, 0x17, 0xac, 0x45, 0xf8, 0xc8, 0x3a, 0x70,
-	0xdc, 0xc9, 0xff, 0xf5, 0xc0, 0xc7, 0x79, 0x02, 0x33, 0xb1, 0xfc, 0xe3, 0x7b, 0x0e, 0xfb, 0x81,
-	0x03, 0x4b, 0xf6, 0x31, 0x59, 0x6f, 0x55, 0xe9, 0x81, 0x5c, 0x6f, 0xd5, 0x05, 0x67, 0xeb, 0xaf,
-	0xd2, 0x28, 0x9f, 0x6e, 0x7a, 0xd6, 0x28, 0xe5, 0x53, 0xab, 0x9f, 0x6d, 0xb4, 0xec, 0x1d, 0xf1,
-	0x47, 0x20, 0x2a, 0x76, 0xc3, 0x0c, 0x1d, 0x9d, 0xdf, 0x5e, 0xf3, 0x5f, 0x30, 0x6e, 0x3b, 0xf7,
-	0x1c, 0xf6, 0x75, 0xf1, 0x6f, 0x08, 0xf2, 0x5b, 0xe2, 0x92, 0x17, 0xfd, 0xde, 0x7d, 0x8d, 0xe6,
-	0x74, 0xc3, 0xbd, 0x66, 0xcd, 0x29, 0x6f, 0xa4, 0xb6, 0xc5, 0xe8, 0xe4, 0x9f, 0x5c, 0x64, 0xea,
-	0xbb, 0xf0, 0xc7, 0x17, 0x17, 0x0f, 0x72, 0x2c, 0x06, 0x29, 0xc9, 0x2d, 0x56, 0x7e, 0xc1, 0x66,
-	0xdc, 0x4d, 0x1a, 0xeb, 0x6b, 0xee, 0x2b, 0x17, 0x8e, 0xf5, 0x2e, 0x1d, 0x76, 0x71, 0xc4, 0x87,
-	0x00, 0x59, 0x9c, 0x95, 0xe5, 0xe2, 0x7c, 0xda, 0x82, 0x15, 0x43, 0xb1, 0xb6, 0xbc, 0xa8, 0x70,
-	0x20, 0xb6, 0xf8, 0x35, 0xa1, 0x56, 0x1e, 0xa9, 0x08, 0xe1, 0x35, 0x43, 0x75, 0xd8, 0x01, 0xd1,
-	0x6e, 0xb7, 0xac, 0xaa, 0x4c, 0xa9, 0xe8, 0x70, 0xe3, 0xfb, 0xb0, 0x78, 0x10, 0x45, 0xcf, 0xa6,
-	0x13, 0x7d, 0x6b, 0x61, 0xc7, 0xa1, 0xf6, 0xfd, 0xe4, 0xb4, 0x9b, 0x9b, 0x85, 0x7b, 0x93, 0x9a,
-	0xea, 0xb2, 0x8e, 0xd1, 0xd4, 0xdd, 0x8f, 0xb2, 0x38, 0xee, 0xc7, 0xcc, 0x87, 0x15, 0xed, 0x5c,
-	0xe8, 0x81, 0x77, 0xed, 0x66, 0xcc, 0x08, 0x64, 0xa1, 0x0b, 0xcb, 0xdd, 0x53, 0xa3, 0xbd, 0x9b,
-	0xa8, 0x36, 0xef, 0x39, 0xec, 0x10, 0x5a, 0xbb, 0xbc, 0x1f, 0x0d, 0xb8, 0x0c, 0xe6, 0xac, 0x66,
-	0x03, 0xd7, 0x51, 0xa0, 0xee, 0xa2, 0x05, 0xda, 0xfa, 0x7b, 0xe2, 0xcf, 0x62, 0xfe, 0xcd, 0xbb,
-	0x1f, 0xc9, 0x30, 0xd1, 0xc7, 0x4a, 0x7f, 0xab, 0x38, 0x9a, 0xa5, 0xbf, 0x73, 0x81, 0x37, 0x4b,
-	0x7f, 0x17, 0x02, 0x6f, 0xd6, 0x52, 0xab, 0x38, 0x1e, 0x1b, 0xc1, 0x4a, 0x21, 0x56, 0xc7, 0x5e,
-	0x51, 0x16, 0xf8, 0x82, 0x08, 0x5f, 0xf7, 0xe6, 0xc5, 0x04, 0x76, 0x6f, 0x9b, 0x76, 0x6f, 0x47,
-	0xb0, 0xb8, 0xcb, 0xc5, 0x62, 0x89, 0xd4, 0x88, 0xdc, 0x1b, 0x4b, 0x33, 0xf1, 0x22, 0xaf, 0xc0,
-	0xa9, 0xce, 0x36, 0xd0, 0x94, 0x97, 0xc0, 0xbe, 0x06, 0xcd, 0x87, 0x3c, 0x55, 0xb9, 0x10, 0xda,
-	0xd1, 0xcb, 0x25, 0x47, 0x74, 0x4b, 0x52, 0x29, 0x6c, 0x9e, 0xa1, 0xd6, 0xee, 0xf2, 0xc1, 0x90,
-	0x0b, 0xe5, 0xd4, 0x0b, 0x06, 0x1f, 0xb3, 0xff, 0x4f, 0x8d, 0xeb, 0x64, 0xac, 0x0d, 0xe3, 0x0a,
-	0xdd, 0x6c, 0x7c, 0x39, 0x87, 0x97, 0xb5, 0x1c, 0x46, 0x03, 0x6e, 0xb8, 0x2a, 0x21, 0x34, 0x8d,
-	0x1c, 0x42, 0x2d, 0x40, 0xc5, 0x7c, 0x48, 0x2d, 0x40, 0x25, 0x29, 0x87, 0xee, 0x6d, 0xea, 0xc7,
-	0x65, 0x37, 0xb3, 0x7e, 0x44, 0x9a, 0x61, 0xd6, 0xd3, 0xdd, 0x8f, 0xfc, 0x71, 0xfa, 0x31, 0xfb,
-	0x80, 0xde, 0x5b, 0x9a, 0xf9, 0x1e, 0x99, 0xe7, 0x9a, 0x4f, 0x0d, 0xd1, 0x8b, 0x65, 0x54, 0xd9,
-	0xde, 0xac, 0xe8, 0x8a, 0x3c, 0x9a, 0xcf, 0x00, 0x1c, 0xa5, 0xd1, 0x64, 0xd7, 0xe7, 0xe3, 0x28,
-	0xcc, 0x74, 0x6d, 0x96, 0xd3, 0x90, 0xe9, 0x2f, 0x23, 0xb1, 0x81, 0x7d, 0x60, 0xb8, 0xfa, 0x56,
-	0xba, 0x8c, 0x62, 0xae, 0x0b, 0xd3, 0x1e, 0xf4, 0x82, 0x94, 0xa4, 0x3e, 0xdc, 0x73, 0xd8, 0x36,
-	0x40, 0x16, 0xac, 0xd5, 0x8e, 0x7b, 0x21, 0x0e, 0xac, 0xd5, 0x5e, 0x49, 0x64, 0xf7, 0x10, 0x1a,
-	0x59, 0xf4, 0xef, 0x6a, 0x96, 0x07, 0x6a, 0xc5, 0x0a, 0xb5, 0x05, 0x2f, 0xc4, 0xe4, 0xdc, 0x36,
-	0x2d, 0x15, 0xb0, 0x3a, 0x2e, 0x15, 0x05, 0xda, 0x02, 0x58, 0x15, 0x03, 0xd4, 0xee, 0x08, 0xdd,
-	0xd2, 0xab, 0x99, 0x94, 0xc4, 0xc5, 0xb4, 0x34, 0x97, 0x86, 0x95, 0xac, 0x23, 0x3c, 0x72, 0xab,
-	0xc8, 0x10, 0x40, 0xd5, 0x3c, 0x86, 0x95, 0x42, 0x4c, 0x44, 0x8b, 0xf4, 0x45, 0xa1, 0x28, 0x2d,
-	0xd2, 0x17, 0x86, 0x53, 0xdc, 0x75, 0xea, 0x72, 0xd9, 0x05, 0xec, 0x32, 0x39, 0x0f, 0xd2, 0xfe,
-	0xe9, 0x3b, 0xce, 0xe6, 0xf1, 0x3c, 0xfd, 0x71, 0xe0, 0xa7, 0xfe, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0x6a, 0x4a, 0x26, 0x19, 0x6a, 0x50, 0x00, 0x00,
+	// 6652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0x4d, 0x8c, 0x1c, 0xdb,
+	0x55, 0x76, 0x75, 0xf7, 0xcc, 0x74, 0x9f, 0xee, 0xe9, 0xee, 0xb9, 0xf3, 0xd7, 0x2e, 0xdb, 0xef,
+	0xcd, 0xab, 0x58, 0xcf, 0x66, 0x78, 0x78, 0xfc, 0x26, 0xc9, 0xd3, 0xcb, 0x33, 0x24, 0x19, 0xcf,
+	0x8c, 0x3d, 0x4e, 0xe6, 0xd9, 0x93, 0x1a, 0x3b, 0x26, 0x09, 0xa8, 0x53, 0xd3, 0x7d, 0x67, 0xa6,
+	0xe2, 0xee, 0xaa, 0x4e, 0x55, 0xf5, 0x8c, 0x3b, 0xc6, 0x12, 0x7f, 0x02, 0x09, 0xf1, 0x14, 0x21,
+	0x90, 0x50, 0x90, 0x10, 0x28, 0xb0, 0x48, 0x96, 0x2c, 0xc8, 0x06, 0x90, 0x58, 0xb0, 0x01, 0x09,
+	0xb1, 0xc8, 0x0a, 0x21, 0x21, 0x24, 0xd8, 0x00, 0x3b, 0x24, 0x96, 0x20, 0x74, 0xee, 0x5f, 0xdd,
+	0x5b, 0x55, 0xed, 0x71, 0xfe, 0xd8, 0xf5, 0xfd, 0xce, 0xa9, 0xfb, 0x7b, 0xce, 0xb9, 0xe7, 0x9e,
+	0x7b, 0x6e, 0x43, 0x2d, 0x1a, 0xf5, 0x6e, 0x8d, 0xa2, 0x30, 0x09, 0xc9, 0xcc, 0x20, 0x88, 0x46,
+	0x3d, 0xfb, 0xea, 0x49, 0x18, 0x9e, 0x0c, 0xe8, 0x86, 0x37, 0xf2, 0x37, 0xbc, 0x20, 0x08, 0x13,
+	0x2f, 0xf1, 0xc3, 0x20, 0xe6, 0x4c, 0xce, 0x57, 0xa1, 0x79, 0x9f, 0x06, 0x87, 0x94, 0xf6, 0x5d,
+	0xfa, 0xf5, 0x31, 0x8d, 0x13, 0xf2, 0xd3, 0xb0, 0xe0, 0xd1, 0x6f, 0x50, 0xda, 0xef, 0x8e, 0xbc,
+	0x38, 0x1e, 0x9d, 0x46, 0x5e, 0x4c, 0x3b, 0xd6, 0x9a, 0x75, 0xb3, 0xe1, 0xb6, 0x39, 0xe1, 0x40,
+	0xe1, 0xe4, 0x2d, 0x68, 0xc4, 0xc8, 0x4a, 0x83, 0x24, 0x0a, 0x47, 0x93, 0x4e, 0x89, 0xf1, 0xd5,
+	0x11, 0xdb, 0xe5, 0x90, 0x33, 0x80, 0x96, 0x6a, 0x21, 0x1e, 0x85, 0x41, 0x4c, 0xc9, 0x6d, 0x58,
+	0xea, 0xf9, 0xa3, 0x53, 0x1a, 0x75, 0xd9, 0xc7, 0xc3, 0x80, 0x0e, 0xc3, 0xc0, 0xef, 0x75, 0xac,
+	0xb5, 0xf2, 0xcd, 0x9a, 0x4b, 0x38, 0x0d, 0xbf, 0xf8, 0x50, 0x50, 0xc8, 0x0d, 0x68, 0xd1, 0x80,
+	0xe3, 0xb4, 0xcf, 0xbe, 0x12, 0x4d, 0x35, 0x53, 0x18, 0x3f, 0x70, 0xfe, 0xc6, 0x82, 0x85, 0x07,
+	0x81, 0x9f, 0x3c, 0xf5, 0x06, 0x03, 0x9a, 0xc8, 0x31, 0xdd, 0x80, 0xd6, 0x39, 0x03, 0xd8, 0x98,
+	0xce, 0xc3, 0xa8, 0x2f, 0x46, 0xd4, 0xe4, 0xf0, 0x81, 0x40, 0xa7, 0xf6, 0xac, 0x34, 0xb5, 0x67,
+	0x85, 0xd3, 0x55, 0x9e, 0x32, 0x5d, 0x37, 0xa0, 0x15, 0xd1, 0x5e, 0x78, 0x46, 0xa3, 0x49, 0xf7,
+	0xdc, 0x0f, 0xfa, 0xe1, 0x79, 0xa7, 0xb2, 0x66, 0xdd, 0x9c, 0x71, 0x9b, 0x12, 0x7e, 0xca, 0x50,
+	0x67, 0x09, 0x88, 0x3e, 0x0a, 0x3e, 0x6f, 0xce, 0x09, 0x2c, 0x3e, 0x09, 0x06, 0x61, 0xef, 0xd9,
+	0x0f, 0x39, 0xba, 0x82, 0xe6, 0x4b, 0x85, 0xcd, 0xaf, 0xc0, 0x92, 0xd9, 0x90, 0xe8, 0x00, 0x85,
+	0xe5, 0xed, 0x53, 0x2f, 0x38, 0xa1, 0xb2, 0x4a, 0xd9, 0x85, 0x9f, 0x82, 0x76, 0x6f, 0x1c, 0x45,
+	0x34, 0xc8, 0xf5, 0xa1, 0x25, 0x70, 0xd5, 0x89, 0xb7, 0xa0, 0x11, 0xd0, 0xf3, 0x94, 0x4d, 0x88,
+	0x4c, 0x40, 0xcf, 0x25, 0x8b, 0xd3, 0x81, 0x95, 0x6c, 0x33, 0xa2, 0x03, 0xdf, 0x2a, 0x41, 0xfd,
+	0x71, 0xe4, 0x05, 0xb1, 0xd7, 0x43, 0x29, 0x26, 0x1d, 0x98, 0x4b, 0x9e, 0x77, 0x4f, 0xbd, 0xf8,
+	0x94, 0x35, 0x57, 0x73, 0x65, 0x91, 0xac, 0xc0, 0xac, 0x37, 0x0c, 0xc7, 0x41, 0xc2, 0x1a, 0x28,
+	0xbb, 0xa2, 0x44, 0xde, 0x81, 0x85, 0x60, 0x3c, 0xec, 0xf6, 0xc2, 0xe0, 0xd8, 0x8f, 0x86, 0x5c,
+	0x17, 0xd8, 0x7a, 0xcd, 0xb8, 0x79, 0x02, 0x79, 0x03, 0xe0, 0x08, 0xe7, 0x81, 0x37, 0x51, 0x61,
+	0x4d, 0x68, 0x08, 0x71, 0xa0, 0x21, 0x4a, 0xd4, 0x3f, 0x39, 0x4d, 0x3a, 0x33, 0xac, 0x22, 0x03,
+	0xc3, 0x3a, 0x12, 0x7f, 0x48, 0xbb, 0x71, 0xe2, 0x0d, 0x47, 0x9d, 0x59, 0xd6, 0x1b, 0x0d, 0x61,
+	0xf4, 0x30, 0xf1, 0x06, 0xdd, 0x63, 0x4a, 0xe3, 0xce, 0x9c, 0xa0, 0x2b, 0x84, 0xbc, 0x0d, 0xcd,
+	0x3e, 0x8d, 0x93, 0xae, 0xd7, 0xef, 0x47, 0x34, 0x8e, 0x69, 0xdc, 0xa9, 0x32, 0x69, 0xcc, 0xa0,
+	0x38, 0x6b, 0xf7, 0x69, 0xa2, 0xcd, 0x4e, 0x2c, 0x56, 0xc7, 0xd9, 0x07, 0xa2, 0xc1, 0x3b, 0x34,
+	0xf1, 0xfc, 0x41, 0x4c, 0xde, 0x83, 0x46, 0xa2, 0x31, 0x33, 0xed, 0xab, 0x6f, 0x92, 0x5b, 0xcc,
+	0x6c, 0xdc, 0xd2, 0x3e, 0x70, 0x0d, 0x3e, 0xe7, 0x3e, 0x54, 0xef, 0x51, 0xba, 0xef, 0x0f, 0xfd,
+	0x84, 0xac, 0xc0, 0xcc, 0xb1, 0xff, 0x9c, 0xf2, 0xc5, 0x2e, 0xef, 0x5d, 0x72, 0x79, 0x91, 0xd8,
+	0x30, 0x37, 0xa2, 0x51, 0x8f, 0xca, 0xe9, 0xdf, 0xbb, 0xe4, 0x4a, 0xe0, 0xee, 0x1c, 0xcc, 0x0c,
+	0xf0, 0x63, 0xe7, 0x3b, 0x25, 0xa8, 0x1f, 0xd2, 0x40, 0x09, 0x11, 0x81, 0x0a, 0x0e, 0x49, 0x08,
+	0x0e, 0xfb, 0x4d, 0xde, 0x84, 0x3a, 0x1b, 0x66, 0x9c, 0x44, 0x7e, 0x70, 0xc2, 0x2a, 0xab, 0xb9,
+	0x80, 0xd0, 0x21, 0x43, 0x48, 0x1b, 0xca, 0xde, 0x30, 0x61, 0x2b, 0x58, 0x76, 0xf1, 0x27, 0x0a,
+	0xd8, 0xc8, 0x9b, 0x0c, 0x51, 0x16, 0xd5, 0xaa, 0x35, 0xdc, 0xba, 0xc0, 0xf6, 0x70, 0xd9, 0x6e,
+	0xc1, 0xa2, 0xce, 0x22, 0x6b, 0x9f, 0x61, 0xb5, 0x2f, 0x68, 0x9c, 0xa2, 0x91, 0x1b, 0xd0, 0x92,
+	0xfc, 0x11, 0xef, 0x2c, 0x5b, 0xc7, 0x9a, 0xdb, 0x14, 0xb0, 0x1c, 0xc2, 0x4d, 0x68, 0x1f, 0xfb,
+	0x81, 0x37, 0xe8, 0xf6, 0x06, 0xc9, 0x59, 0xb7, 0x4f, 0x07, 0x89, 0xc7, 0x56, 0x74, 0xc6, 0x6d,
+	0x32, 0x7c, 0x7b, 0x90, 0x9c, 0xed, 0x20, 0x4a, 0xde, 0x81, 0xda, 0x31, 0xa5, 0x5d, 0x36, 0x13,
+	0x9d, 0xea, 0x9a, 0x75, 0xb3, 0xbe, 0xd9, 0x12, 0x53, 0x2f, 0x67, 0xd7, 0xad, 0x1e, 0x8b, 0x5f,
+	0xce, 0xef, 0x59, 0xd0, 0xe0, 0x53, 0x25, 0x4c, 0xe8, 0x75, 0x98, 0x97, 0x3d, 0xa2, 0x51, 0x14,
+	0x46, 0x42, 0xfc, 0x4d, 0x90, 0xac, 0x43, 0x5b, 0x02, 0xa3, 0x88, 0xfa, 0x43, 0xef, 0x84, 0x0a,
+	0x7d, 0xcb, 0xe1, 0x64, 0x33, 0xad, 0x31, 0x0a, 0xc7, 0x09, 0x37, 0x62, 0xf5, 0xcd, 0x86, 0xe8,
+	0x94, 0x8b, 0x98, 0x6b, 0xb2, 0x38, 0x1f, 0x59, 0x40, 0xb0, 0x5b, 0x8f, 0x43, 0x4e, 0x16, 0xb3,
+	0x90, 0x5d, 0x01, 0xeb, 0xb5, 0x57, 0xa0, 0x34, 0x6d, 0x05, 0xae, 0xc3, 0x2c, 0x6b, 0x12, 0x75,
+	0xb5, 0x9c, 0xeb, 0x96, 0xa0, 0x39, 0xdf, 0xb6, 0xa0, 0x81, 0x96, 0x23, 0xa0, 0x83, 0x83, 0xd0,
+	0x0f, 0x12, 0x72, 0x1b, 0xc8, 0xf1, 0x38, 0xe8, 0xfb, 0xc1, 0x49, 0x37, 0x79, 0xee, 0xf7, 0xbb,
+	0x47, 0x13, 0xac, 0x82, 0xf5, 0x67, 0xef, 0x92, 0x5b, 0x40, 0x23, 0xef, 0x40, 0xdb, 0x40, 0xe3,
+	0x24, 0xe2, 0xbd, 0xda, 0xbb, 0xe4, 0xe6, 0x28, 0xa8, 0xff, 0xe1, 0x38, 0x19, 0x8d, 0x93, 0xae,
+	0x1f, 0xf4, 0xe9, 0x73, 0x36, 0x67, 0xf3, 0xae, 0x81, 0xdd, 0x6d, 0x42, 0x43, 0xff, 0xce, 0xf9,
+	0x34, 0xb4, 0xf7, 0xd1, 0x30, 0x04, 0x7e, 0x70, 0xb2, 0xc5, 0xb5, 0x17, 0xad, 0xd5, 0x68, 0x7c,
+	0xf4, 0x8c, 0x4e, 0xc4, 0x3a, 0x8a, 0x12, 0xaa, 0xc4, 0x69, 0x18, 0x27, 0x62, 0x5e, 0xd8, 0x6f,
+	0xe7, 0x5f, 0x2d, 0x68, 0xe1, 0xa4, 0x7f, 0xe8, 0x05, 0x13, 0x39, 0xe3, 0xfb, 0xd0, 0xc0, 0xaa,
+	0x1e, 0x87, 0x5b, 0xdc, 0xe6, 0x71, 0x5d, 0xbe, 0x29, 0x26, 0x29, 0xc3, 0x7d, 0x4b, 0x67, 0xc5,
+	0x6d, 0x7a, 0xe2, 0x1a, 0x5f, 0xa3, 0xd2, 0x25, 0x5e, 0x74, 0x42, 0x13, 0x66, 0x0d, 0x85, 0x75,
+	0x04, 0x0e, 0x6d, 0x87, 0xc1, 0x31, 0x59, 0x83, 0x46, 0xec, 0x25, 0xdd, 0x11, 0x8d, 0xd8, 0xac,
+	0x31, 0xc5, 0x29, 0xbb, 0x10, 0x7b, 0xc9, 0x01, 0x8d, 0xee, 0x4e, 0x12, 0x6a, 0x7f, 0x06, 0x16,
+	0x72, 0xad, 0xa0, 0xae, 0xa6, 0x43, 0xc4, 0x9f, 0x64, 0x09, 0x66, 0xce, 0xbc, 0xc1, 0x98, 0x0a,
+	0x23, 0xcd, 0x0b, 0x1f, 0x94, 0xde, 0xb7, 0x9c, 0xb7, 0xa1, 0x9d, 0x76, 0x5b, 0x08, 0x3d, 0x81,
+	0x0a, 0xce, 0xa0, 0xa8, 0x80, 0xfd, 0x76, 0x7e, 0xc5, 0xe2, 0x8c, 0xdb, 0xa1, 0xaf, 0x0c, 0x1e,
+	0x32, 0xa2, 0x5d, 0x94, 0x8c, 0xf8, 0x7b, 0xea, 0x86, 0xf0, 0xa3, 0x0f, 0xd6, 0xb9, 0x01, 0x0b,
+	0x5a, 0x17, 0x5e, 0xd1, 0xd9, 0x8f, 0x2c, 0x58, 0x78, 0x48, 0xcf, 0xc5, 0xaa, 0xcb, 0xde, 0xbe,
+	0x0f, 0x95, 0x64, 0x32, 0xe2, 0x4e, 0x56, 0x73, 0xf3, 0xba, 0x58, 0xb4, 0x1c, 0xdf, 0x2d, 0x51,
+	0x7c, 0x3c, 0x19, 0x51, 0x97, 0x7d, 0xe1, 0x7c, 0x1a, 0xea, 0x1a, 0x48, 0x56, 0x61, 0xf1, 0xe9,
+	0x83, 0xc7, 0x0f, 0x77, 0x0f, 0x0f, 0xbb, 0x07, 0x4f, 0xee, 0x7e, 0x7e, 0xf7, 0x4b, 0xdd, 0xbd,
+	0xad, 0xc3, 0xbd, 0xf6, 0x25, 0xb2, 0x02, 0xe4, 0xe1, 0xee, 0xe1, 0xe3, 0xdd, 0x1d, 0x03, 0xb7,
+	0x9c, 0x5b, 0x40, 0xf4, 0x66, 0x44, 0xcf, 0x3b, 0x30, 0x27, 0x76, 0x15, 0xb9, 0xa9, 0x8a, 0xa2,
+	0xf3, 0x36, 0x90, 0x43, 0xff, 0x24, 0xf8, 0x90, 0xc6, 0xb1, 0x77, 0xa2, 0xd4, 0xbd, 0x0d, 0xe5,
+	0x61, 0x7c, 0x22, 0xb4, 0x1c, 0x7f, 0x3a, 0x1f, 0x87, 0x45, 0x83, 0x4f, 0x54, 0x7c, 0x15, 0x6a,
+	0xb1, 0x7f, 0x12, 0x78, 0xc9, 0x38, 0xa2, 0xa2, 0xea, 0x14, 0x70, 0xee, 0xc1, 0xd2, 0x17, 0x69,
+	0xe4, 0x1f, 0x4f, 0x2e, 0xaa, 0xde, 0xac, 0xa7, 0x94, 0xad, 0x67, 0x17, 0x96, 0x33, 0xf5, 0x88,
+	0xe6, 0xb9, 0xb0, 0x89, 0x25, 0xa9, 0xba, 0xbc, 0xa0, 0xa9, 0x5e, 0x49, 0x57, 0x3d, 0xe7, 0x09,
+	0x90, 0xed, 0x30, 0x08, 0x68, 0x2f, 0x39, 0xa0, 0x34, 0x4a, 0xbd, 0xe3, 0x54, 0xb2, 0xea, 0x9b,
+	0xab, 0x62, 0xad, 0xb2, 0xfa, 0x2c, 0x44, 0x8e, 0x40, 0x65, 0x44, 0xa3, 0x21, 0xab, 0xb8, 0xea,
+	0xb2, 0xdf, 0xce, 0x32, 0x2c, 0x1a, 0xd5, 0x0a, 0xc7, 0xe6, 0x5d, 0x58, 0xde, 0xf1, 0xe3, 0x5e,
+	0xbe, 0xc1, 0x0e, 0xcc, 0x8d, 0xc6, 0x47, 0xdd, 0x54, 0x6f, 0x64, 0x11, 0xf7, 0xfb, 0xec, 0x27,
+	0xa2, 0xb2, 0xdf, 0xb0, 0xa0, 0xb2, 0xf7, 0x78, 0x7f, 0x9b, 0xd8, 0x50, 0xf5, 0x83, 0x5e, 0x38,
+	0x44, 0xd3, 0xca, 0x07, 0xad, 0xca, 0x53, 0xf5, 0xe1, 0x2a, 0xd4, 0x98, 0x45, 0x46, 0x17, 0x46,
+	0x38, 0xb2, 0x29, 0x80, 0xee, 0x13, 0x7d, 0x3e, 0xf2, 0x23, 0xe6, 0x1f, 0x49, 0xaf, 0xa7, 0xc2,
+	0xac, 0x5e, 0x9e, 0xe0, 0xfc, 0x6f, 0x05, 0xe6, 0x84, 0x3d, 0x66, 0xed, 0xf5, 0x12, 0xff, 0x8c,
+	0x8a, 0x9e, 0x88, 0x12, 0xee, 0x64, 0x11, 0x1d, 0x86, 0x09, 0xed, 0x1a, 0xcb, 0x60, 0x82, 0xc8,
+	0xd5, 0xe3, 0x15, 0x75, 0x47, 0x68, 0xd9, 0x59, 0xcf, 0x6a, 0xae, 0x09, 0xe2, 0x64, 0x21, 0xd0,
+	0xf5, 0xfb, 0xac, 0x4f, 0x15, 0x57, 0x16, 0x71, 0x26, 0x7a, 0xde, 0xc8, 0xeb, 0xf9, 0xc9, 0x44,
+	0x28, 0xb0, 0x2a, 0x63, 0xdd, 0x83, 0xb0, 0xe7, 0x0d, 0xba, 0x47, 0xde, 0xc0, 0x0b, 0x7a, 0x54,
+	0xf8, 0x68, 0x26, 0x88, 0x6e, 0x98, 0xe8, 0x92, 0x64, 0xe3, 0xae, 0x5a, 0x06, 0x45, 0x77, 0xae,
+	0x17, 0x0e, 0x87, 0x7e, 0x82, 0xde, 0x1b, 0xdb, 0xd9, 0xcb, 0xae, 0x86, 0xb0, 0x91, 0xf0, 0xd2,
+	0x39, 0x9f, 0xbd, 0x1a, 0x6f, 0xcd, 0x00, 0xb1, 0x16, 0x74, 0x0f, 0xd0, 0xe8, 0x3c, 0x3b, 0xef,
+	0x00, 0xaf, 0x25, 0x45, 0x70, 0x1d, 0xc6, 0x41, 0x4c, 0x93, 0x64, 0x40, 0xfb, 0xaa, 0x43, 0x75,
+	0xc6, 0x96, 0x27, 0x90, 0xdb, 0xb0, 0xc8, 0x1d, 0xca, 0xd8, 0x4b, 0xc2, 0xf8, 0xd4, 0x8f, 0xbb,
+	0x31, 0xba, 0x66, 0x0d, 0xc6, 0x5f, 0x44, 0x22, 0xef, 0xc3, 0x6a, 0x06, 0x8e, 0x68, 0x8f, 0xfa,
+	0x67, 0xb4, 0xdf, 0x99, 0x67, 0x5f, 0x4d, 0x23, 0x93, 0x35, 0xa8, 0xa3, 0x1f, 0x3d, 0x1e, 0xf5,
+	0x3d, 0xdc, 0x6b, 0x9b, 0x6c, 0x1d, 0x74, 0x88, 0xbc, 0x0b, 0xf3, 0x23, 0xca, 0x37, 0xc4, 0xd3,
+	0x64, 0xd0, 0x8b, 0x3b, 0x2d, 0xb6, 0x5b, 0xd5, 0x85, 0x32, 0xa1, 0xe4, 0xba, 0x26, 0x07, 0x0a,
+	0x65, 0x2f, 0x66, 0x0e, 0x95, 0x37, 0xe9, 0xb4, 0x99, 0xb8, 0xa5, 0x00, 0xd3, 0x91, 0xc8, 0x3f,
+	0xf3, 0x12, 0xda, 0x59, 0x60, 0xb2, 0x25, 0x8b, 0xce, 0x1f, 0x59, 0xb0, 0xb8, 0xef, 0xc7, 0x89,
+	0x10, 0x42, 0x65, 0x72, 0xdf, 0x84, 0x3a, 0x17, 0xbf, 0x6e, 0x18, 0x0c, 0x26, 0x42, 0x22, 0x81,
+	0x43, 0x8f, 0x82, 0xc1, 0x84, 0x7c, 0x0c, 0xe6, 0xfd, 0x40, 0x67, 0xe1, 0x3a, 0xdc, 0xf0, 0x03,
+	0x8d, 0xe9, 0x4d, 0xa8, 0x8f, 0xc6, 0x47, 0x03, 0xbf, 0xc7, 0x59, 0xca, 0xbc, 0x16, 0x0e, 0x31,
+	0x06, 0x74, 0x84, 0x78, 0x4f, 0x38, 0x47, 0x85, 0x71, 0xd4, 0x05, 0x86, 0x2c, 0xce, 0x5d, 0x58,
+	0x32, 0x3b, 0x28, 0x8c, 0xd5, 0x3a, 0x54, 0x85, 0x6c, 0xc7, 0x9d, 0x3a, 0x9b, 0x9f, 0xa6, 0x98,
+	0x1f, 0xc1, 0xea, 0x2a, 0xba, 0xf3, 0xbd, 0x0a, 0x2c, 0x0a, 0x74, 0x7b, 0x10, 0xc6, 0xf4, 0x70,
+	0x3c, 0x1c, 0x7a, 0x51, 0x81, 0xd2, 0x58, 0x17, 0x28, 0x4d, 0xc9, 0x54, 0x1a, 0x14, 0xe5, 0x53,
+	0xcf, 0x0f, 0xb8, 0x17, 0xc7, 0x35, 0x4e, 0x43, 0xc8, 0x4d, 0x68, 0xf5, 0x06, 0x61, 0xcc, 0x3d,
+	0x1b, 0xfd, 0x88, 0x94, 0x85, 0xf3, 0x4a, 0x3e, 0x53, 0xa4, 0xe4, 0xba, 0x92, 0xce, 0x66, 0x94,
+	0xd4, 0x81, 0x06, 0x56, 0x4a, 0xa5, 0xcd, 0x99, 0xe3, 0x9e, 0x96, 0x8e, 0x61, 0x7f, 0xb2, 0x2a,
+	0xc1, 0xf5, 0xaf, 0x55, 0xa4, 0x10, 0x78, 0x02, 0x43, 0x9b, 0xa6, 0x71, 0xd7, 0x84, 0x42, 0xe4,
+	0x49, 0xe4, 0x1e, 0x00, 0x6f, 0x8b, 0x6d, 0xd5, 0xc0, 0xb6, 0xea, 0xb7, 0xcd, 0x15, 0xd1, 0xe7,
+	0xfe, 0x16, 0x16, 0xc6, 0x11, 0x65, 0x9b, 0xb5, 0xf6, 0xa5, 0xf3, 0x5b, 0x16, 0xd4, 0x35, 0x1a,
+	0x59, 0x86, 0x85, 0xed, 0x47, 0x8f, 0x0e, 0x76, 0xdd, 0xad, 0xc7, 0x0f, 0xbe, 0xb8, 0xdb, 0xdd,
+	0xde, 0x7f, 0x74, 0xb8, 0xdb, 0xbe, 0x84, 0xf0, 0xfe, 0xa3, 0xed, 0xad, 0xfd, 0xee, 0xbd, 0x47,
+	0xee, 0xb6, 0x84, 0x2d, 0xdc, 0xc8, 0xdd, 0xdd, 0x0f, 0x1f, 0x3d, 0xde, 0x35, 0xf0, 0x12, 0x69,
+	0x43, 0xe3, 0xae, 0xbb, 0xbb, 0xb5, 0xbd, 0x27, 0x90, 0x32, 0x59, 0x82, 0xf6, 0xbd, 0x27, 0x0f,
+	0x77, 0x1e, 0x3c, 0xbc, 0xdf, 0xdd, 0xde, 0x7a, 0xb8, 0xbd, 0xbb, 0xbf, 0xbb, 0xd3, 0xae, 0x90,
+	0x79, 0xa8, 0x6d, 0xdd, 0xdd, 0x7a, 0xb8, 0xf3, 0xe8, 0xe1, 0xee, 0x4e, 0x7b, 0xc6, 0xf9, 0x67,
+	0x0b, 0x96, 0x59, 0xaf, 0xfb, 0x59, 0x05, 0x59, 0x83, 0x7a, 0x2f, 0x0c, 0x47, 0x34, 0xf2, 0x34,
+	0x93, 0xad, 0x43, 0x28, 0xfc, 0xdc, 0x40, 0x1e, 0x87, 0x51, 0x8f, 0x0a, 0xfd, 0x00, 0x06, 0xdd,
+	0x43, 0x04, 0x85, 0x5f, 0x2c, 0x2f, 0xe7, 0xe0, 0xea, 0x51, 0xe7, 0x18, 0x67, 0x59, 0x81, 0xd9,
+	0xa3, 0x88, 0x7a, 0xbd, 0x53, 0xa1, 0x19, 0xa2, 0x84, 0xe1, 0x04, 0xe9, 0x32, 0xf7, 0x70, 0xf6,
+	0x07, 0xb4, 0xcf, 0x24, 0xa6, 0xea, 0xb6, 0x04, 0xbe, 0x2d, 0x60, 0xb4, 0x0c, 0xde, 0x91, 0x17,
+	0xf4, 0xc3, 0x80, 0xf6, 0x99, 0xd0, 0x54, 0xdd, 0x14, 0x70, 0x0e, 0x60, 0x25, 0x3b, 0x3e, 0xa1,
+	0x5f, 0xef, 0x69, 0xfa, 0xc5, 0xbd, 0x65, 0x7b, 0xfa, 0x6a, 0x6a, 0xba, 0xf6, 0x1f, 0x16, 0x54,
+	0x70, 0xb3, 0x9d, 0xbe, 0x31, 0xeb, 0xfe, 0x53, 0xd9, 0xf0, 0x9f, 0x58, 0x38, 0x01, 0x4f, 0x19,
+	0xdc, 0xfc, 0xf2, 0x2d, 0x4a, 0x43, 0x52, 0x7a, 0x44, 0x7b, 0x67, 0x9d, 0x19, 0x9d, 0x8e, 0x08,
+	0x2a, 0x08, 0xba, 0xa2, 0xec, 0x6b, 0xa1, 0x20, 0xb2, 0x2c, 0x69, 0xec, 0xcb, 0xb9, 0x94, 0xc6,
+	0xbe, 0xeb, 0xc0, 0x9c, 0x1f, 0x1c, 0x85, 0xe3, 0xa0, 0xcf, 0x14, 0xa2, 0xea, 0xca, 0x22, 0x4e,
+	0xdf, 0x88, 0x29, 0xaa, 0x3f, 0x94, 0xe2, 0x9f, 0x02, 0x0e, 0xc1, 0xa3, 0x4a, 0xcc, 0x9c, 0x0b,
+	0x15, 0x4c, 0x78, 0x0f, 0x16, 0x34, 0x4c, 0xcc, 0xe6, 0x5b, 0x30, 0x33, 0x42, 0xa0, 0x63, 0x19,
+	0xa6, 0x1c, 0x99, 0x5c, 0x4e, 0x71, 0xda, 0x18, 0x69, 0x4c, 0x1e, 0x04, 0xc7, 0xa1, 0xac, 0xe9,
+	0x9b, 0x15, 0x68, 0x29, 0x48, 0x54, 0x74, 0x13, 0x5a, 0x7e, 0x9f, 0x06, 0x89, 0x9f, 0x4c, 0xba,
+	0xc6, 0x89, 0x28, 0x0b, 0xa3, 0x37, 0xe7, 0x0d, 0x7c, 0x2f, 0x16, 0xfe, 0x02, 0x2f, 0x90, 0x4d,
+	0x58, 0xc2, 0xad, 0x46, 0xee, 0x1e, 0x6a, 0x89, 0xf9, 0xc1, 0xac, 0x90, 0x86, 0xc6, 0x00, 0x71,
+	0x61, 0xed, 0xd5, 0x27, 0xdc, 0xab, 0x29, 0x22, 0xe1, 0xac, 0xf1, 0x9a, 0x70, 0xc8, 0x33, 0x7c,
+	0x3b, 0x52, 0x40, 0x2e, 0x28, 0x34, 0xcb, 0x4d, 0x55, 0x36, 0x28, 0xa4, 0x05, 0x96, 0xaa, 0xb9,
+	0xc0, 0x12, 0x9a, 0xb2, 0x49, 0xd0, 0xa3, 0xfd, 0x6e, 0x12, 0x76, 0x99, 0xc9, 0x65, 0xab, 0x53,
+	0x75, 0xb3, 0x30, 0xae, 0x6d, 0x42, 0xe3, 0x24, 0xa0, 0x09, 0xb3, 0x4a, 0x55, 0x57, 0x16, 0x51,
+	0xbb, 0x18, 0x0b, 0xdf, 0x40, 0x6a, 0xae, 0x28, 0xa1, 0x5b, 0x3a, 0x8e, 0xfc, 0xb8, 0xd3, 0x60,
+	0x28, 0xfb, 0x4d, 0x3e, 0x01, 0xcb, 0x47, 0x34, 0x4e, 0xba, 0xa7, 0xd4, 0xeb, 0xd3, 0x88, 0xad,
+	0x3e, 0x8f, 0x57, 0xf1, 0xdd, 0xbe, 0x98, 0x88, 0x6d, 0x9f, 0xd1, 0x28, 0xf6, 0xc3, 0x80, 0xed,
+	0xf3, 0x35, 0x57, 0x16, 0xb1, 0x3e, 0x9c, 0x10, 0x3f, 0xc8, 0x4c, 0x5d, 0xa7, 0xc5, 0x26, 0xa3,
+	0x98, 0xe8, 0x7c, 0x83, 0xf9, 0xdc, 0x2a, 0xfe, 0xf6, 0x84, 0x39, 0x0c, 0xe4, 0x0a, 0xd4, 0xf8,
+	0xcc, 0xc4, 0xa7, 0x9e, 0x38, 0x06, 0x54, 0x19, 0x70, 0x78, 0xea, 0xa1, 0x95, 0x31, 0x26, 0x9b,
+	0x07, 0x34, 0xeb, 0x0c, 0xdb, 0xe3, 0x73, 0x7d, 0x1d, 0x9a, 0x32, 0xb2, 0x17, 0x77, 0x07, 0xf4,
+	0x38, 0x91, 0xc7, 0xf4, 0x60, 0x3c, 0xc4, 0xe6, 0xe2, 0x7d, 0x7a, 0x9c, 0x38, 0x0f, 0x61, 0x41,
+	0x68, 0xfe, 0xa3, 0x11, 0x95, 0x4d, 0x7f, 0xaa, 0x68, 0x07, 0xad, 0x6f, 0x2e, 0x9a, 0xa6, 0x82,
+	0xc5, 0x1a, 0x32, 0xdb, 0xaa, 0xe3, 0x02, 0xd1, 0x2d, 0x89, 0xa8, 0x50, 0x6c, 0x63, 0x32, 0x18,
+	0x20, 0x86, 0x63, 0x60, 0x38, 0xab, 0xf1, 0xb8, 0xd7, 0x43, 0xfb, 0xc1, 0xad, 0xaa, 0x2c, 0x3a,
+	0xdf, 0xb1, 0x60, 0x91, 0xd5, 0x26, 0x6a, 0x4e, 0x4f, 0x90, 0xaf, 0xdf, 0xcd, 0x46, 0x4f, 0x2b,
+	0xa1, 0x16, 0xe9, 0xf6, 0x9b, 0x17, 0x7e, 0xf0, 0x33, 0x71, 0x25, 0x77, 0x26, 0xfe, 0x47, 0x0b,
+	0x16, 0xb8, 0x09, 0x4d, 0xbc, 0x64, 0x1c, 0x8b, 0xe1, 0xff, 0x2c, 0xcc, 0xf3, 0xbd, 0x50, 0x28,
+	0xa1, 0xe8, 0xe8, 0x92, 0xb2, 0x17, 0x0c, 0xe5, 0xcc, 0x7b, 0x97, 0x5c, 0x93, 0x99, 0x7c, 0x06,
+	0x1a, 0x7a, 0x78, 0x96, 0xf5, 0xb9, 0xbe, 0x79, 0x59, 0x8e, 0x32, 0x27, 0x39, 0x7b, 0x97, 0x5c,
+	0xe3, 0x03, 0x72, 0x87, 0x39, 0x34, 0x41, 0x97, 0x55, 0xdb, 0x29, 0x9b, 0x9f, 0xe7, 0x16, 0x6b,
+	0xef, 0x92, 0xab, 0xb1, 0xdf, 0xad, 0xc2, 0x2c, 0xf7, 0x60, 0x9d, 0xfb, 0x30, 0x6f, 0xf4, 0xd4,
+	0x38, 0xeb, 0x37, 0xf8, 0x59, 0x3f, 0x17, 0x1a, 0x2a, 0xe5, 0x43, 0x43, 0xce, 0x9f, 0x95, 0x81,
+	0xa0, 0xb4, 0x65, 0x96, 0x13, 0x5d, 0xe8, 0xb0, 0x6f, 0x1c, 0x88, 0x1a, 0xae, 0x0e, 0x91, 0x5b,
+	0x40, 0xb4, 0xa2, 0x8c, 0x9e, 0xf1, 0xdd, 0xa6, 0x80, 0x82, 0x66, 0x51, 0x6c, 0xd6, 0x62, 0x5b,
+	0x15, 0x47, 0x3f, 0xbe, 0x6e, 0x85, 0x34, 0xdc, 0x50, 0x46, 0x63, 0x0c, 0xcd, 0x79, 0x89, 0x3c,
+	0x32, 0xc9, 0x72, 0x56, 0x40, 0x66, 0x2f, 0x14, 0x90, 0xb9, 0xac, 0x80, 0xe8, 0x4e, 0x7b, 0xd5,
+	0x70, 0xda, 0xd1, 0x59, 0x1c, 0xa2, 0x8b, 0x99, 0x0c, 0x7a, 0xdd, 0x21, 0xb6, 0x2e, 0x4e, 0x48,
+	0x06, 0x88, 0xb1, 0x4d, 0xe1, 0x5e, 0xa4, 0x27, 0x03, 0x60, 0x73, 0x9c, 0xc3, 0xd1, 0x5e, 0xe3,
+	0xc7, 0xcc, 0x02, 0xb0, 0x53, 0xd2, 0x8c, 0x9b, 0x02, 0x78, 0x96, 0x8a, 0x51, 0xc4, 0xba, 0xe3,
+	0x40, 0x48, 0x0b, 0xed, 0xb3, 0xb3, 0x51, 0xd5, 0xcd, 0x13, 0x9c, 0xef, 0x5b, 0xd0, 0xc6, 0x35,
+	0x33, 0xe4, 0xfa, 0x03, 0x60, 0x6a, 0xf5, 0x9a, 0x62, 0x6d, 0xf0, 0xfe, 0xe8, 0x52, 0xfd, 0x3e,
+	0xd4, 0x58, 0x85, 0xe1, 0x88, 0x06, 0x42, 0xa8, 0x3b, 0xa6, 0x50, 0xa7, 0x16, 0x6d, 0xef, 0x92,
+	0x9b, 0x32, 0x6b, 0x22, 0xfd, 0x0f, 0x16, 0xd4, 0x45, 0x37, 0x7f, 0xe8, 0xc8, 0x81, 0x0d, 0x55,
+	0x94, 0x6e, 0xed, 0x78, 0xae, 0xca, 0xb8, 0x9f, 0x0d, 0x31, 0x3c, 0x83, 0x1b, 0xb8, 0x11, 0x35,
+	0xc8, 0xc2, 0xb8, 0x1b, 0x33, 0xe3, 0x1d, 0x77, 0x13, 0x7f, 0xd0, 0x95, 0x54, 0x71, 0xb3, 0x52,
+	0x44, 0x42, 0x1b, 0x16, 0x27, 0x18, 0xda, 0xe6, 0x1b, 0x2d, 0x2f, 0x60, 0x78, 0x44, 0x0c, 0x28,
+	0xe3, 0xdb, 0x3a, 0x7f, 0xd5, 0x80, 0xd5, 0x1c, 0x49, 0x5d, 0x4d, 0x8a, 0xe3, 0xf0, 0xc0, 0x1f,
+	0x1e, 0x85, 0xea, 0x60, 0x60, 0xe9, 0x27, 0x65, 0x83, 0x44, 0x4e, 0x60, 0x59, 0x7a, 0x14, 0x38,
+	0xa7, 0xe9, 0x4e, 0x57, 0x62, 0xae, 0xd0, 0xbb, 0xa6, 0x0c, 0x64, 0x1b, 0x94, 0xb8, 0x6e, 0x05,
+	0x8a, 0xeb, 0x23, 0xa7, 0xd0, 0x91, 0x04, 0xb9, 0x5d, 0x68, 0xee, 0x0d, 0xb6, 0xf5, 0xce, 0x05,
+	0x6d, 0x19, 0xae, 0xb0, 0x3b, 0xb5, 0x36, 0x32, 0x81, 0x37, 0x24, 0x8d, 0xed, 0x07, 0xf9, 0xf6,
+	0x2a, 0xaf, 0x35, 0x36, 0xe6, 0xe4, 0x9b, 0x8d, 0x5e, 0x50, 0x31, 0xf9, 0x1a, 0xac, 0x9c, 0x7b,
+	0x7e, 0x22, 0xbb, 0xa5, 0x39, 0x0e, 0x33, 0xac, 0xc9, 0xcd, 0x0b, 0x9a, 0x7c, 0xca, 0x3f, 0x36,
+	0x36, 0xc9, 0x29, 0x35, 0xda, 0x7f, 0x67, 0x41, 0xd3, 0xac, 0x07, 0xc5, 0x54, 0x18, 0x0f, 0x69,
+	0x44, 0xa5, 0xfb, 0x99, 0x81, 0xf3, 0x67, 0xeb, 0x52, 0xd1, 0xd9, 0x5a, 0x3f, 0xd1, 0x96, 0x2f,
+	0x0a, 0x3b, 0x55, 0x5e, 0x2f, 0xec, 0x34, 0x53, 0x14, 0x76, 0xb2, 0xff, 0xdb, 0x02, 0x92, 0x97,
+	0x25, 0x72, 0x9f, 0x1f, 0xee, 0x03, 0x3a, 0x10, 0x36, 0xe9, 0x67, 0x5e, 0x4f, 0x1e, 0xe5, 0xdc,
+	0xc9, 0xaf, 0x51, 0x31, 0x74, 0xa3, 0xa3, 0xbb, 0x5b, 0xf3, 0x6e, 0x11, 0x29, 0x13, 0x08, 0xab,
+	0x5c, 0x1c, 0x08, 0x9b, 0xb9, 0x38, 0x10, 0x36, 0x9b, 0x0d, 0x84, 0xd9, 0xbf, 0x6e, 0xc1, 0x62,
+	0xc1, 0xa2, 0xff, 0xf8, 0x06, 0x8e, 0xcb, 0x64, 0xd8, 0x82, 0x92, 0x58, 0x26, 0x1d, 0xb4, 0x7f,
+	0x09, 0xe6, 0x0d, 0x41, 0xff, 0xf1, 0xb5, 0x9f, 0xf5, 0x18, 0xb9, 0x9c, 0x19, 0x98, 0xfd, 0x9f,
+	0x25, 0x20, 0x79, 0x65, 0xfb, 0x7f, 0xed, 0x43, 0x7e, 0x9e, 0xca, 0x05, 0xf3, 0xf4, 0x13, 0xdd,
+	0x07, 0xde, 0x81, 0x05, 0x91, 0xc7, 0xa0, 0x85, 0x74, 0xb8, 0xc4, 0xe4, 0x09, 0xe8, 0x33, 0x9b,
+	0x51, 0xc8, 0xaa, 0x71, 0xff, 0xad, 0x6d, 0x86, 0x99, 0x60, 0x24, 0x66, 0x47, 0xf0, 0xbc, 0x88,
+	0xbb, 0xbc, 0x2a, 0xb9, 0xaf, 0xfc, 0xa1, 0x05, 0xcb, 0x19, 0x42, 0x7a, 0x5b, 0xcb, 0xb7, 0x0e,
+	0x73, 0x3f, 0x31, 0x41, 0xec, 0xbf, 0x72, 0x33, 0x32, 0xd2, 0x96, 0x27, 0xe0, 0xfc, 0x8c, 0x83,
+	0x1c, 0x2c, 0x66, 0xbd, 0x88, 0xe4, 0xac, 0xf2, 0xec, 0x8d, 0x80, 0x0e, 0x32, 0x1d, 0x3f, 0x86,
+	0x95, 0x2c, 0x21, 0xbd, 0x0a, 0x32, 0xbb, 0x2c, 0x8b, 0xe8, 0x51, 0x1a, 0xdb, 0x94, 0xd9, 0xdf,
+	0x42, 0x9a, 0xf3, 0x3d, 0x0b, 0xc8, 0x17, 0xc6, 0x34, 0x9a, 0xb0, 0x5b, 0x5b, 0x15, 0x6b, 0x5a,
+	0xcd, 0x46, 0x52, 0xf0, 0x0a, 0xe6, 0xf3, 0x74, 0x22, 0xef, 0xf6, 0x4b, 0xe9, 0xdd, 0xfe, 0x35,
+	0x00, 0x3c, 0xca, 0xa9, 0xab, 0x60, 0xe6, 0xc9, 0x05, 0xe3, 0x21, 0xaf, 0xb0, 0xf0, 0xfa, 0xbd,
+	0x72, 0xf1, 0xf5, 0xfb, 0xcc, 0x45, 0xd7, 0xef, 0x77, 0x60, 0xd1, 0xe8, 0xb7, 0x5a, 0x56, 0x79,
+	0x29, 0x6d, 0xbd, 0xe2, 0x52, 0xfa, 0x37, 0x4b, 0x50, 0xde, 0x0b, 0x47, 0x7a, 0x9c, 0xd5, 0x32,
+	0xe3, 0xac, 0x62, 0x2f, 0xe9, 0xaa, 0xad, 0x42, 0x98, 0x18, 0x03, 0x24, 0xeb, 0xd0, 0xf4, 0x86,
+	0x09, 0x1e, 0xfc, 0x8f, 0xc3, 0xe8, 0xdc, 0x8b, 0xfa, 0x7c, 0xad, 0xef, 0x96, 0x3a, 0x96, 0x9b,
+	0xa1, 0x90, 0x25, 0x28, 0x2b, 0xa3, 0xcb, 0x18, 0xb0, 0x88, 0x8e, 0x1b, 0xbb, 0xa3, 0x99, 0x88,
+	0x98, 0x85, 0x28, 0xa1, 0x28, 0x99, 0xdf, 0x73, 0xb7, 0x9b, 0xab, 0x4e, 0x11, 0x09, 0xf7, 0x35,
+	0x9c, 0x3e, 0xc6, 0x26, 0x82, 0x4d, 0xb2, 0xac, 0x07, 0xc6, 0xaa, 0xe6, 0x8d, 0xd5, 0xbf, 0x5b,
+	0x30, 0xc3, 0xe6, 0x06, 0xcd, 0x00, 0x97, 0x7d, 0x15, 0x6a, 0x65, 0x73, 0x32, 0xef, 0x66, 0x61,
+	0xe2, 0x18, 0xd9, 0x31, 0x25, 0x35, 0x20, 0x0d, 0x25, 0x6b, 0x50, 0xe3, 0x25, 0x95, 0x09, 0xc2,
+	0x58, 0x52, 0x90, 0xbc, 0x81, 0xf7, 0xe8, 0x23, 0xe9, 0xb7, 0x80, 0xbc, 0x69, 0x08, 0x47, 0x2e,
+	0xc3, 0xd3, 0xfe, 0x60, 0x7d, 0x7c, 0x58, 0x7c, 0x37, 0xca, 0xc2, 0xb8, 0x1f, 0xab, 0x6a, 0xf5,
+	0x69, 0xca, 0xa0, 0xce, 0x3a, 0xb4, 0x1e, 0x86, 0x7d, 0xaa, 0xc5, 0xbb, 0xa6, 0xca, 0xb9, 0xf3,
+	0xcb, 0x16, 0x54, 0x25, 0x33, 0xb9, 0x09, 0x15, 0x74, 0x32, 0x32, 0x47, 0x08, 0x75, 0xc3, 0x88,
+	0x7c, 0x2e, 0xe3, 0x40, 0xab, 0xcc, 0xe2, 0x1a, 0xa9, 0xc3, 0x29, 0xa3, 0x1a, 0x0a, 0x4b, 0xbb,
+	0x9b, 0x71, 0x43, 0x32, 0xa8, 0xf3, 0x5d, 0x0b, 0xe6, 0x8d, 0x36, 0xf0, 0x10, 0x3a, 0xf0, 0xe2,
+	0x44, 0xdc, 0xda, 0x88, 0xe5, 0xd1, 0x21, 0x7d, 0xa1, 0x4b, 0x66, 0x04, 0x54, 0xc5, 0xe6, 0xca,
+	0x7a, 0x6c, 0xee, 0x36, 0xd4, 0xd2, 0x1c, 0xa6, 0x8a, 0x61, 0x6d, 0xb1, 0x45, 0x79, 0x77, 0x9a,
+	0x32, 0x61, 0x3d, 0xbd, 0x70, 0x10, 0x46, 0xe2, 0xba, 0x80, 0x17, 0x9c, 0x3b, 0x50, 0xd7, 0xf8,
+	0xb1, 0x1b, 0x01, 0x4d, 0xce, 0xc3, 0xe8, 0x99, 0x0c, 0xc4, 0x8a, 0xa2, 0x4a, 0x03, 0x28, 0xa5,
+	0x69, 0x00, 0xce, 0xdf, 0x5a, 0x30, 0x8f, 0x32, 0xe8, 0x07, 0x27, 0x07, 0xe1, 0xc0, 0xef, 0x4d,
+	0xd8, 0xda, 0x4b, 0x71, 0x13, 0x36, 0x43, 0xca, 0xa2, 0x09, 0xa3, 0xd4, 0xcb, 0x33, 0xa8, 0x50,
+	0x51, 0x55, 0x46, 0x1d, 0x46, 0x0d, 0x38, 0xf2, 0x62, 0xa1, 0x16, 0x62, 0xfb, 0x33, 0x40, 0xd4,
+	0x34, 0x04, 0x22, 0x2f, 0xa1, 0xdd, 0xa1, 0x3f, 0x18, 0xf8, 0x9c, 0x97, 0x3b, 0x47, 0x45, 0x24,
+	0x6c, 0xb3, 0xef, 0xc7, 0xde, 0x51, 0x1a, 0x02, 0x57, 0x65, 0xe7, 0x2f, 0x4a, 0x50, 0x17, 0x86,
+	0x7b, 0xb7, 0x7f, 0x42, 0xc5, 0x7d, 0x0d, 0x16, 0x53, 0x23, 0xa3, 0x21, 0x92, 0x6e, 0x38, 0xac,
+	0x1a, 0x92, 0x5d, 0xf2, 0x72, 0x7e, 0xc9, 0x31, 0xf0, 0x19, 0xf6, 0xe9, 0xbb, 0xcc, 0x33, 0xe6,
+	0x77, 0x3d, 0x29, 0x20, 0xa9, 0x9b, 0x8c, 0x3a, 0x93, 0x52, 0x19, 0xf0, 0xca, 0xdb, 0x9d, 0xf7,
+	0xa1, 0x21, 0xaa, 0x61, 0x6b, 0xd2, 0x99, 0x33, 0x84, 0xdf, 0x58, 0x2f, 0xd7, 0xe0, 0x94, 0x5f,
+	0x6e, 0xca, 0x2f, 0xab, 0x17, 0x7d, 0x29, 0x39, 0x9d, 0xfb, 0xea, 0xd2, 0xec, 0x7e, 0xe4, 0x8d,
+	0x4e, 0xa5, 0x96, 0xde, 0x86, 0x45, 0x3f, 0xe8, 0x0d, 0xc6, 0x7d, 0xda, 0x1d, 0x07, 0x5e, 0x10,
+	0x84, 0xe3, 0xa0, 0x47, 0x65, 0xce, 0x40, 0x11, 0xc9, 0xe9, 0x43, 0x43, 0xaf, 0x88, 0xac, 0xc3,
+	0x0c, 0x36, 0x24, 0x77, 0x85, 0x62, 0x15, 0xe6, 0x2c, 0xe4, 0x26, 0xcc, 0xd0, 0xfe, 0x09, 0x95,
+	0xa7, 0x45, 0x62, 0x9e, 0xdb, 0x71, 0x55, 0x5d, 0xce, 0x80, 0x06, 0x05, 0xd1, 0x8c, 0x41, 0x31,
+	0x77, 0x14, 0x8c, 0xf0, 0x06, 0x0f, 0xfa, 0x98, 0x3e, 0xfa, 0x90, 0xeb, 0x80, 0xc6, 0xee, 0xfc,
+	0x5a, 0x19, 0xea, 0x1a, 0x8c, 0xb6, 0xe1, 0x04, 0x3b, 0xdc, 0xed, 0xfb, 0xde, 0x90, 0x26, 0x34,
+	0x12, 0x72, 0x9f, 0x41, 0x91, 0xcf, 0x3b, 0x3b, 0xe9, 0x86, 0xe3, 0xa4, 0xdb, 0xa7, 0x27, 0x11,
+	0xe5, 0x9b, 0xbc, 0xe5, 0x66, 0x50, 0xe4, 0x1b, 0x7a, 0xcf, 0x75, 0x3e, 0x2e, 0x41, 0x19, 0x54,
+	0x46, 0xcf, 0xf9, 0x1c, 0x55, 0xd2, 0xe8, 0x39, 0x9f, 0x91, 0xac, 0x55, 0x9b, 0x29, 0xb0, 0x6a,
+	0xef, 0xc1, 0x0a, 0xb7, 0x5f, 0x42, 0xd3, 0xbb, 0x19, 0xc1, 0x9a, 0x42, 0xc5, 0x98, 0x11, 0xf6,
+	0x59, 0xaa, 0x44, 0xec, 0x7f, 0x83, 0x47, 0xa6, 0x2c, 0x37, 0x87, 0x23, 0x2f, 0x0b, 0x11, 0xe9,
+	0xbc, 0xfc, 0x36, 0x31, 0x87, 0x33, 0x5e, 0xef, 0xb9, 0xc9, 0x5b, 0x13, 0xbc, 0x19, 0xdc, 0x99,
+	0x87, 0xfa, 0x61, 0x12, 0x8e, 0xe4, 0xa2, 0x34, 0xa1, 0xc1, 0x8b, 0x22, 0x77, 0xe3, 0x0a, 0x5c,
+	0x66, 0x52, 0xf4, 0x38, 0x1c, 0x85, 0x83, 0xf0, 0x64, 0x72, 0x38, 0x3e, 0x8a, 0x7b, 0x91, 0x3f,
+	0xc2, 0x93, 0x95, 0xf3, 0xf7, 0x16, 0x2c, 0x1a, 0x54, 0x11, 0x7e, 0xfa, 0x04, 0x57, 0x02, 0x75,
+	0xe9, 0xce, 0x05, 0x6f, 0x41, 0x33, 0xae, 0x9c, 0x91, 0x07, 0x11, 0xf9, 0xef, 0x98, 0x6c, 0x41,
+	0x4b, 0xf6, 0x4c, 0x7e, 0xc8, 0xa5, 0xb0, 0x93, 0x97, 0x42, 0xf1, 0x7d, 0x53, 0x7c, 0x20, 0xab,
+	0xf8, 0x39, 0x71, 0x2b, 0xdb, 0x67, 0x63, 0x94, 0x71, 0x08, 0x75, 0x93, 0xa6, 0x9f, 0x46, 0x64,
+	0x0f, 0x7a, 0x0a, 0x8c, 0x9d, 0xdf, 0xb6, 0x00, 0xd2, 0xde, 0xb1, 0xbb, 0x3c, 0xb5, 0x41, 0xf0,
+	0x64, 0xf0, 0x14, 0xc0, 0x48, 0xbf, 0xba, 0x03, 0x4a, 0xf7, 0x9c, 0xba, 0xc4, 0xd0, 0x61, 0xbc,
+	0x01, 0xad, 0x93, 0x41, 0x78, 0xc4, 0x36, 0x6c, 0x96, 0x0c, 0x14, 0x8b, 0x0c, 0x96, 0x26, 0x87,
+	0xef, 0x09, 0x34, 0xdd, 0xa0, 0x2a, 0xda, 0x06, 0xe5, 0x7c, 0x54, 0x82, 0x85, 0xdc, 0x98, 0xa7,
+	0x6a, 0x19, 0xd9, 0xcc, 0x99, 0xd3, 0x29, 0x21, 0x77, 0x16, 0x71, 0x3b, 0xb8, 0x30, 0x20, 0x70,
+	0x07, 0x9a, 0x11, 0xb7, 0x57, 0xd2, 0x98, 0x55, 0x5e, 0x61, 0xcc, 0xe6, 0x23, 0xbd, 0x88, 0x57,
+	0xa6, 0x5e, 0xff, 0x8c, 0x46, 0x89, 0xcf, 0x8e, 0x64, 0xcc, 0x85, 0xe0, 0x26, 0xb8, 0xa5, 0xe1,
+	0x6c, 0x67, 0xbf, 0x01, 0x2d, 0x91, 0x35, 0xa4, 0x38, 0x45, 0x36, 0x6b, 0x0a, 0x23, 0xa3, 0xf3,
+	0x27, 0xf2, 0xba, 0xc1, 0x5c, 0xc3, 0xe9, 0x33, 0xa2, 0x8f, 0xae, 0x94, 0x19, 0xdd, 0xc7, 0x44,
+	0xe8, 0xbf, 0x2f, 0xcf, 0x7d, 0x65, 0xed, 0x06, 0xbf, 0x2f, 0xae, 0x6a, 0xcc, 0x29, 0xad, 0xbc,
+	0xce, 0x94, 0x62, 0x40, 0x76, 0x6e, 0x2f, 0x1c, 0xed, 0x89, 0x5c, 0x06, 0xa6, 0x08, 0x2a, 0xef,
+	0x4e, 0x16, 0x5f, 0x91, 0xe5, 0x50, 0xb8, 0x73, 0xcf, 0x67, 0x77, 0xee, 0xcf, 0xc2, 0x15, 0x04,
+	0x46, 0x51, 0x38, 0x0a, 0x23, 0x54, 0x46, 0x6f, 0xc0, 0xb7, 0xe9, 0x30, 0x48, 0x4e, 0xa5, 0x19,
+	0x7b, 0x15, 0x0b, 0x3b, 0xde, 0xe1, 0xb1, 0x84, 0x3b, 0xdd, 0xc2, 0xd3, 0xe0, 0xd6, 0x2d, 0x4f,
+	0x70, 0x3e, 0x05, 0x35, 0xe6, 0x2a, 0xb3, 0x61, 0xbd, 0x03, 0xb5, 0xd3, 0x70, 0xd4, 0x3d, 0xf5,
+	0x83, 0x44, 0x2a, 0x77, 0x33, 0xf5, 0x61, 0xf7, 0xd8, 0x84, 0x28, 0x06, 0xe7, 0xf7, 0x67, 0x60,
+	0xee, 0x41, 0x70, 0x16, 0xfa, 0x3d, 0x76, 0x33, 0x31, 0xa4, 0xc3, 0x50, 0x66, 0x21, 0xe2, 0x6f,
+	0x9c, 0x0a, 0x96, 0xad, 0x33, 0x4a, 0xc4, 0xd5, 0x82, 0x2c, 0xa2, 0x83, 0x10, 0xa5, 0x99, 0xc2,
+	0x5c, 0x75, 0x34, 0x04, 0x0f, 0x10, 0x91, 0x9e, 0x54, 0x2d, 0x4a, 0x69, 0x1a, 0xe7, 0x8c, 0x96,
+	0xc6, 0x89, 0xed, 0x88, 0xbc, 0x0b, 0x71, 0x31, 0x2f, 0x8b, 0xec, 0xc0, 0x13, 0x51, 0x1e, 0x2d,
+	0x62, 0xae, 0xc6, 0x9c, 0x38, 0xf0, 0xe8, 0x20, 0xba, 0x23, 0xfc, 0x03, 0xce, 0xc3, 0x8d, 0xaf,
+	0x0e, 0xa1, 0xeb, 0x96, 0xcd, 0xcb, 0xae, 0x71, 0x99, 0xcf, 0xc0, 0x68, 0xa1, 0xfb, 0x54, 0x19,
+	0x52, 0x3e, 0x06, 0xe0, 0x99, 0xd0, 0x59, 0x5c, 0x3b, 0x26, 0xf1, 0x84, 0x2a, 0x51, 0x62, 0x82,
+	0xe2, 0x0d, 0x06, 0x47, 0x5e, 0xef, 0x19, 0x4b, 0xbb, 0x67, 0x77, 0x04, 0x35, 0xd7, 0x04, 0xb1,
+	0xd7, 0xda, 0x6a, 0xb2, 0xfb, 0xd3, 0x8a, 0xab, 0x43, 0x64, 0x13, 0xea, 0xec, 0x68, 0x28, 0xd6,
+	0xb3, 0xc9, 0xd6, 0xb3, 0xad, 0x9f, 0x1d, 0xd9, 0x8a, 0xea, 0x4c, 0xfa, 0x6d, 0x49, 0xcb, 0xbc,
+	0x2d, 0xe1, 0x46, 0x53, 0x5c, 0x32, 0xb5, 0x59, 0x6b, 0x29, 0x80, 0xbb, 0xa9, 0x98, 0x30, 0xce,
+	0xb0, 0xc0, 0x18, 0x0c, 0x8c, 0xbc, 0x01, 0x55, 0x3c, 0xb6, 0x8c, 0x3c, 0xbf, 0xdf, 0x21, 0xea,
+	0xf4, 0xa4, 0x30, 0xac, 0x43, 0xfe, 0x66, 0x97, 0x41, 0x8b, 0x6c, 0x56, 0x0c, 0x0c, 0xe7, 0x46,
+	0x95, 0x99, 0x12, 0x2d, 0xf1, 0x15, 0x35, 0x40, 0x27, 0x01, 0xb2, 0xd5, 0xef, 0x0b, 0xd9, 0x54,
+	0xc7, 0xe8, 0x54, 0xaa, 0x2c, 0x43, 0xaa, 0x0a, 0x56, 0xb7, 0x54, 0xbc, 0xba, 0xaf, 0x9c, 0x03,
+	0x67, 0x17, 0xea, 0x07, 0x5a, 0xea, 0x39, 0x13, 0x72, 0x99, 0x74, 0x2e, 0x14, 0x43, 0x43, 0xb4,
+	0xee, 0x94, 0xf4, 0xee, 0x38, 0x7f, 0x6a, 0x01, 0xc1, 0xcc, 0x07, 0xd5, 0x7d, 0xde, 0xb6, 0x03,
+	0x0d, 0x15, 0xec, 0x48, 0x73, 0xc9, 0x0c, 0x0c, 0x79, 0x58, 0x57, 0xba, 0xe1, 0xf1, 0x71, 0x4c,
+	0x65, 0xe6, 0x87, 0x81, 0xa1, 0x84, 0xa2, 0x8f, 0x83, 0xfe, 0x82, 0xcf, 0x5b, 0x88, 0x45, 0x06,
+	0x48, 0x0e, 0x47, 0x3b, 0x1b, 0x51, 0xbc, 0x6a, 0x57, 0xaa, 0xa5, 0xca, 0x2a, 0xe5, 0x2d, 0x3b,
+	0xcb, 0xeb, 0x78, 0xa3, 0x23, 0xea, 0x35, 0x4d, 0x88, 0xe4, 0x54, 0x74, 0x34, 0x55, 0xcc, 0xeb,
+	0x37, 0x3a, 0xcd, 0xcd, 0x66, 0x9e, 0x80, 0x97, 0x91, 0xc7, 0x7e, 0x94, 0x65, 0x2f, 0x33, 0xf6,
+	0x02, 0x8a, 0xf3, 0x14, 0x16, 0x45, 0x93, 0xba, 0x73, 0x63, 0x2e, 0xa2, 0x75, 0x91, 0x20, 0x97,
+	0xf2, 0x82, 0xec, 0xfc, 0x8f, 0x05, 0x73, 0x62, 0xa5, 0xd9, 0xb2, 0x64, 0xdf, 0x20, 0xd4, 0x5c,
+	0x03, 0x23, 0x1d, 0x23, 0xfb, 0x9c, 0x49, 0x3d, 0x07, 0xf2, 0x06, 0xaa, 0x5c, 0x64, 0xa0, 0x30,
+	0xbf, 0xd7, 0x4b, 0x4e, 0xd9, 0x59, 0xb6, 0xe6, 0xb2, 0xdf, 0xa4, 0xcd, 0x23, 0x2f, 0xdc, 0x10,
+	0xe2, 0xcf, 0xc2, 0x47, 0x18, 0x7c, 0xbf, 0xcd, 0xe1, 0x38, 0x07, 0xac, 0x03, 0xdd, 0x34, 0xb0,
+	0x92, 0x02, 0x28, 0xb9, 0xbc, 0xc0, 0x34, 0x4c, 0xa4, 0x96, 0xa6, 0x88, 0xf3, 0xd7, 0x25, 0xbe,
+	0xf4, 0x62, 0x0e, 0x62, 0x4d, 0x44, 0x8d, 0xa5, 0xb1, 0x5e, 0x2d, 0x7e, 0xa2, 0x57, 0xb1, 0x98,
+	0xe3, 0x1c, 0x6e, 0x88, 0x5f, 0xd9, 0x14, 0x3f, 0xec, 0x63, 0x9c, 0x78, 0x51, 0xc2, 0x33, 0x8a,
+	0x64, 0x5e, 0x80, 0x42, 0xf0, 0x5b, 0x1a, 0xf4, 0x39, 0x55, 0xdc, 0x2a, 0xcb, 0x32, 0xd9, 0x81,
+	0x6a, 0xcc, 0x6e, 0x55, 0x69, 0xdc, 0x99, 0x5d, 0x2b, 0xdf, 0x6c, 0xaa, 0x17, 0x0c, 0x05, 0xa3,
+	0xba, 0x25, 0xca, 0xfc, 0x1e, 0xd6, 0x55, 0x5f, 0x3a, 0x77, 0x60, 0xde, 0x20, 0x91, 0x06, 0x54,
+	0xef, 0xbb, 0x8f, 0x9e, 0x3c, 0xdc, 0xd9, 0xdd, 0x69, 0x5f, 0xc2, 0x14, 0xb8, 0x07, 0x0f, 0xbb,
+	0xf7, 0xf6, 0x1f, 0xdc, 0xdf, 0x7b, 0xdc, 0xb6, 0xb0, 0xb8, 0xfd, 0xe8, 0xc3, 0x83, 0xfd, 0xdd,
+	0xc7, 0xbb, 0x3b, 0xed, 0x92, 0xf3, 0xc7, 0x16, 0xcf, 0xc7, 0x4c, 0x1b, 0x4b, 0xd5, 0x47, 0xcd,
+	0x8b, 0xa9, 0x3e, 0x82, 0xd5, 0x55, 0xf4, 0x9f, 0xb0, 0xfa, 0xd8, 0xd0, 0xd9, 0xa1, 0x03, 0x9a,
+	0xd0, 0xad, 0xc1, 0x20, 0x33, 0x27, 0x78, 0x7a, 0x28, 0xa0, 0x89, 0xa3, 0xc5, 0x31, 0x2c, 0x71,
+	0xe2, 0x81, 0xf9, 0x68, 0x49, 0x13, 0xd0, 0x8c, 0x59, 0xcc, 0xe1, 0x39, 0xb5, 0xe2, 0x26, 0xd2,
+	0xc0, 0x30, 0xce, 0x9c, 0x69, 0x47, 0x74, 0x60, 0x17, 0xae, 0x18, 0x84, 0xf8, 0x2e, 0x3d, 0x0e,
+	0x23, 0x65, 0x49, 0xdf, 0x86, 0x26, 0xd3, 0x2f, 0x0c, 0x59, 0x33, 0x82, 0x88, 0x39, 0x67, 0x50,
+	0xe7, 0xb3, 0x70, 0xb5, 0xb8, 0x1a, 0xb1, 0x54, 0x22, 0x03, 0xb9, 0xcf, 0x78, 0xa4, 0x8b, 0xaa,
+	0x43, 0xce, 0x17, 0x60, 0x79, 0x8b, 0xe7, 0x08, 0xfe, 0xb8, 0x12, 0x69, 0xf0, 0xba, 0x39, 0x5b,
+	0xa5, 0x18, 0xf5, 0x3d, 0x58, 0xd8, 0xa1, 0x47, 0xe3, 0x93, 0x7d, 0x7a, 0x96, 0x36, 0x44, 0xa0,
+	0x12, 0x9f, 0x86, 0xe7, 0x62, 0xb7, 0x60, 0xbf, 0x31, 0xb8, 0x3d, 0x40, 0x9e, 0x6e, 0x3c, 0xa2,
+	0x3d, 0xf9, 0xae, 0x81, 0x21, 0x87, 0x23, 0xda, 0x73, 0xde, 0x03, 0xa2, 0xd7, 0x93, 0x0e, 0x36,
+	0x1e, 0x1f, 0x75, 0xe3, 0x49, 0x9c, 0xd0, 0xa1, 0x7c, 0xb0, 0xa1, 0x43, 0xce, 0x0d, 0x68, 0x1c,
+	0x78, 0xf8, 0xf6, 0x47, 0x3c, 0xa5, 0xc2, 0x30, 0xa4, 0x37, 0xc1, 0xbd, 0x53, 0x85, 0x21, 0x19,
+	0xd9, 0xf9, 0xaf, 0x12, 0xcc, 0x72, 0x4e, 0xac, 0xb5, 0x4f, 0xe3, 0xc4, 0x0f, 0x78, 0x4a, 0x82,
+	0xa8, 0x55, 0x83, 0x0a, 0x05, 0x21, 0x6b, 0x5f, 0xc5, 0x51, 0x5e, 0xe6, 0x88, 0x0b, 0x23, 0x6a,
+	0x60, 0x68, 0xf1, 0xd2, 0x64, 0x33, 0x6e, 0x2e, 0x52, 0x20, 0x13, 0xb1, 0x4e, 0x5d, 0x31, 0xde,
+	0x3f, 0xb9, 0x75, 0x08, 0x73, 0xaa, 0x43, 0x85, 0x0e, 0xdf, 0x1c, 0x17, 0xea, 0x2c, 0x9e, 0x77,
+	0xec, 0xaa, 0xaf, 0xe1, 0xd8, 0xf1, 0xf3, 0xfd, 0xab, 0x1c, 0x3b, 0x78, 0x0d, 0xc7, 0x0e, 0x53,
+	0x2c, 0xef, 0x51, 0xea, 0x52, 0x3c, 0x32, 0x48, 0x2d, 0xfe, 0x96, 0x05, 0x6d, 0x21, 0x45, 0x8a,
+	0x46, 0xde, 0x32, 0x8e, 0x46, 0x85, 0x99, 0xdc, 0xd7, 0x61, 0x9e, 0x1d, 0x58, 0x54, 0x68, 0x5e,
+	0xdc, 0x23, 0x18, 0x20, 0x8e, 0x43, 0xde, 0x9f, 0x0e, 0xfd, 0x81, 0x58, 0x14, 0x1d, 0x92, 0xd1,
+	0xfd, 0xc8, 0x13, 0x99, 0x5d, 0x96, 0xab, 0xca, 0xce, 0x5f, 0x5a, 0xb0, 0xa0, 0x75, 0x58, 0x48,
+	0xe1, 0x1d, 0x90, 0xda, 0xc0, 0xe3, 0xf4, 0xdc, 0x42, 0xae, 0x9a, 0x6a, 0x93, 0x7e, 0x66, 0x30,
+	0xb3, 0xc5, 0xf4, 0x26, 0xac, 0x83, 0xf1, 0x78, 0x28, 0x0c, 0xa5, 0x0e, 0xa1, 0x20, 0x9d, 0x53,
+	0xfa, 0x4c, 0xb1, 0x70, 0xe3, 0x68, 0x60, 0x38, 0xf8, 0x21, 0x1e, 0xb4, 0x14, 0x13, 0x77, 0xb2,
+	0x4c, 0xd0, 0xf9, 0x27, 0x0b, 0x16, 0xf9, 0x89, 0x59, 0xc4, 0x23, 0xd4, 0x33, 0x9b, 0x59, 0x1e,
+	0x22, 0xe0, 0x1a, 0xb9, 0x77, 0xc9, 0x15, 0x65, 0xf2, 0xc9, 0xd7, 0x3c, 0xe5, 0xab, 0x6c, 0xb1,
+	0x29, 0x6b, 0x51, 0x2e, 0x5a, 0x8b, 0x57, 0xcc, 0x74, 0x51, 0x5c, 0x7a, 0xa6, 0x30, 0x2e, 0x8d,
+	0x2f, 0x6a, 0xe3, 0x5e, 0x38, 0xa2, 0x78, 0x33, 0x69, 0x0e, 0x4e, 0x98, 0xa0, 0x6f, 0x5b, 0xd0,
+	0xb9, 0xc7, 0xef, 0x6f, 0xf0, 0x4e, 0xd3, 0x8f, 0x93, 0x30, 0x52, 0x6f, 0x07, 0xcd, 0x1d, 0x5b,
+	0x44, 0x8d, 0xa7, 0xec, 0xd8, 0x7c, 0x6d, 0x54, 0x39, 0xe7, 0x59, 0x88, 0x33, 0xbd, 0x8e, 0xa1,
+	0x59, 0x97, 0x1e, 0x04, 0x3d, 0x63, 0xfb, 0x27, 0x3f, 0x2c, 0x67, 0x50, 0xe7, 0xcf, 0x2d, 0x68,
+	0xa5, 0x9d, 0xdc, 0x45, 0xd0, 0xb4, 0x0e, 0xc2, 0x27, 0x54, 0x80, 0x8a, 0x67, 0xfb, 0xe8, 0x24,
+	0x8a, 0xbe, 0x69, 0x08, 0xd3, 0x58, 0x51, 0x0a, 0xc7, 0xd2, 0xeb, 0xd6, 0x21, 0x9e, 0xca, 0x84,
+	0xfb, 0xab, 0x70, 0xb5, 0x45, 0x89, 0xa5, 0x70, 0x0f, 0x13, 0xf6, 0xd5, 0x2c, 0x23, 0xc8, 0xa2,
+	0xf4, 0xef, 0xe6, 0x18, 0x8a, 0x3f, 0x9d, 0x6f, 0x5a, 0x70, 0xb9, 0x60, 0x72, 0x85, 0x66, 0xec,
+	0xc0, 0xc2, 0xb1, 0x22, 0xca, 0x09, 0xe0, 0xea, 0xb1, 0x22, 0x2f, 0x1c, 0xcd, 0x41, 0xbb, 0xf9,
+	0x0f, 0x94, 0x47, 0xc1, 0xa7, 0xd4, 0xc8, 0x28, 0xcc, 0x13, 0x36, 0x7f, 0xa7, 0x0c, 0x4d, 0x7e,
+	0x11, 0xcd, 0x5f, 0xf1, 0xd3, 0x88, 0x7c, 0x08, 0x73, 0xe2, 0x5f, 0x18, 0xc8, 0xb2, 0x68, 0xd6,
+	0xfc, 0xdf, 0x07, 0x7b, 0x25, 0x0b, 0x0b, 0xd9, 0x59, 0xfc, 0xd5, 0xef, 0xff, 0xdb, 0xef, 0x96,
+	0xe6, 0x49, 0x7d, 0xe3, 0xec, 0xdd, 0x8d, 0x13, 0x1a, 0xc4, 0x58, 0xc7, 0x2f, 0x00, 0xa4, 0xff,
+	0x4f, 0x40, 0x3a, 0xea, 0x20, 0x91, 0xf9, 0xe3, 0x05, 0xfb, 0x72, 0x01, 0x45, 0xd4, 0x7b, 0x99,
+	0xd5, 0xbb, 0xe8, 0x34, 0xb1, 0x5e, 0x3f, 0xf0, 0x13, 0xfe, 0x67, 0x05, 0x1f, 0x58, 0xeb, 0xa4,
+	0x0f, 0x0d, 0xfd, 0xef, 0x07, 0x88, 0x8c, 0x27, 0x16, 0xfc, 0xf9, 0x81, 0x7d, 0xa5, 0x90, 0x26,
+	0x83, 0xa9, 0xac, 0x8d, 0x65, 0xa7, 0x8d, 0x6d, 0x8c, 0x19, 0x47, 0xda, 0xca, 0x00, 0x9a, 0xe6,
+	0xbf, 0x0c, 0x90, 0xab, 0x9a, 0x5a, 0xe7, 0xfe, 0xe3, 0xc0, 0xbe, 0x36, 0x85, 0x2a, 0xda, 0xba,
+	0xc6, 0xda, 0x5a, 0x75, 0x08, 0xb6, 0xd5, 0x63, 0x3c, 0xf2, 0x3f, 0x0e, 0x3e, 0xb0, 0xd6, 0x37,
+	0xff, 0x65, 0x0d, 0x6a, 0xea, 0x06, 0x80, 0x7c, 0x0d, 0xe6, 0x8d, 0x4c, 0x01, 0x22, 0x87, 0x51,
+	0x94, 0x58, 0x60, 0x5f, 0x2d, 0x26, 0x8a, 0x86, 0xdf, 0x60, 0x0d, 0x77, 0xc8, 0x0a, 0x36, 0x2c,
+	0xae, 0xda, 0x37, 0x58, 0x7e, 0x04, 0x4f, 0x10, 0x7f, 0x06, 0x4d, 0xf3, 0x76, 0xdf, 0x18, 0x67,
+	0x2e, 0x1b, 0xc0, 0xbe, 0x36, 0x85, 0x2a, 0x9a, 0xbb, 0xca, 0x9a, 0x5b, 0x21, 0x4b, 0x7a, 0x73,
+	0x2a, 0x32, 0x4f, 0x59, 0x4a, 0xbf, 0xfe, 0x27, 0x04, 0xe4, 0x9a, 0x12, 0xac, 0xa2, 0x3f, 0x27,
+	0x50, 0x22, 0x92, 0xff, 0x87, 0x02, 0xa7, 0xc3, 0x9a, 0x22, 0x84, 0x2d, 0x9f, 0xfe, 0x1f, 0x04,
+	0xe4, 0x2b, 0x50, 0x53, 0x2f, 0x6e, 0xc9, 0xaa, 0xf6, 0xcc, 0x59, 0x7f, 0x06, 0x6c, 0x77, 0xf2,
+	0x84, 0x22, 0xc1, 0xd0, 0x6b, 0x46, 0xc1, 0xd8, 0x87, 0x65, 0x71, 0x30, 0x3d, 0xa2, 0x3f, 0xc8,
+	0x48, 0x0a, 0xfe, 0x3a, 0xe1, 0xb6, 0x45, 0xee, 0x40, 0x55, 0x3e, 0x64, 0x26, 0x2b, 0xc5, 0x0f,
+	0xb2, 0xed, 0xd5, 0x1c, 0x2e, 0xac, 0xc7, 0x97, 0x00, 0xd2, 0x07, 0xba, 0x4a, 0xcf, 0x72, 0x4f,
+	0x83, 0xed, 0xcb, 0x05, 0x14, 0x31, 0xd4, 0x15, 0x36, 0xd4, 0x36, 0x61, 0x7a, 0x16, 0xd0, 0x73,
+	0xf9, 0x16, 0x65, 0x07, 0xea, 0xda, 0x1b, 0x5d, 0x22, 0x6b, 0xc8, 0xbf, 0xef, 0xb5, 0xed, 0x22,
+	0x92, 0xe8, 0xe0, 0xe7, 0x60, 0xde, 0x78, 0x6c, 0xab, 0x04, 0xb9, 0xe8, 0x29, 0xaf, 0x7d, 0xb5,
+	0x98, 0x28, 0xea, 0xfa, 0x32, 0xd4, 0xb5, 0xa7, 0xb1, 0x44, 0xcb, 0x80, 0xcd, 0x3c, 0x8a, 0xb5,
+	0xed, 0x22, 0x92, 0x18, 0xef, 0x12, 0x1b, 0x6f, 0xd3, 0xa9, 0xe1, 0x78, 0xd9, 0x83, 0x0c, 0x5c,
+	0xd3, 0xaf, 0x41, 0xd3, 0x7c, 0x2c, 0xab, 0x94, 0xa0, 0xf0, 0xd9, 0xad, 0x7d, 0x6d, 0x0a, 0xd5,
+	0x94, 0x9f, 0xf5, 0x45, 0xd5, 0xc8, 0xc6, 0x0b, 0x71, 0xf9, 0xfd, 0x92, 0x7c, 0x01, 0x6a, 0xea,
+	0x85, 0x0c, 0x59, 0xd5, 0x4f, 0xb0, 0xda, 0x3b, 0x1a, 0xbb, 0x93, 0x27, 0x88, 0xca, 0x17, 0x58,
+	0xe5, 0x75, 0x92, 0x8e, 0x80, 0x9b, 0x6f, 0xf6, 0x52, 0x46, 0x33, 0xdf, 0xfa, 0x63, 0x1a, 0x7b,
+	0x25, 0x0b, 0x17, 0x9b, 0xef, 0xc4, 0xc7, 0x3a, 0x02, 0x68, 0x65, 0x52, 0xc0, 0x94, 0x6c, 0x17,
+	0xe7, 0xcc, 0xda, 0x6f, 0xbc, 0x3a, 0x73, 0xcc, 0xb4, 0x0a, 0xd2, 0x1a, 0x6c, 0xc8, 0x14, 0xe7,
+	0x5f, 0x84, 0x86, 0xfe, 0xc8, 0x51, 0x19, 0xf4, 0x82, 0xa7, 0x99, 0xf6, 0x95, 0x42, 0x9a, 0xb9,
+	0xb8, 0xa4, 0xa1, 0x37, 0x83, 0x8b, 0x6b, 0xbe, 0xf2, 0x4a, 0x2d, 0x5c, 0xd1, 0xe3, 0x36, 0xfb,
+	0xda, 0x14, 0xaa, 0xb9, 0xb8, 0x64, 0xd1, 0x18, 0x0b, 0xbf, 0xa7, 0x20, 0x5f, 0x86, 0x96, 0x96,
+	0x5f, 0x79, 0x38, 0x09, 0x7a, 0x4a, 0x50, 0xf3, 0x99, 0xfc, 0x76, 0x91, 0xa3, 0xe8, 0xac, 0xb2,
+	0xfa, 0x17, 0x1c, 0x63, 0x10, 0x28, 0xa4, 0xdb, 0x50, 0xd7, 0xea, 0x78, 0x55, 0xbd, 0xab, 0x1a,
+	0x49, 0x4f, 0x44, 0xbf, 0x6d, 0x91, 0x3f, 0xc0, 0xff, 0xc0, 0xd0, 0x33, 0x21, 0x8d, 0xdb, 0xb8,
+	0x4c, 0x3d, 0x1d, 0x9d, 0xa6, 0x57, 0xe4, 0xb8, 0xac, 0x93, 0xfb, 0xeb, 0x9f, 0x33, 0x26, 0xe1,
+	0x85, 0x71, 0xe0, 0xb8, 0x95, 0xfd, 0x3f, 0x8c, 0x97, 0x59, 0x06, 0xfd, 0xb5, 0xc3, 0xcb, 0xdb,
+	0x16, 0xf9, 0xae, 0x05, 0x4d, 0xf3, 0x98, 0xac, 0x96, 0xaa, 0xf0, 0x40, 0x6e, 0x5f, 0x9b, 0x42,
+	0x15, 0x4b, 0xf5, 0x65, 0xd6, 0xcb, 0xc7, 0xeb, 0xae, 0xd1, 0x4b, 0xf1, 0xfe, 0xef, 0x47, 0xeb,
+	0x2d, 0xf9, 0x80, 0xff, 0x3b, 0x8d, 0x0c, 0x28, 0x12, 0xcd, 0x46, 0x67, 0x97, 0x57, 0xff, 0x6b,
+	0x96, 0x9b, 0xd6, 0x6d, 0x8b, 0x7c, 0x15, 0x5a, 0xda, 0xb7, 0x4c, 0x4a, 0x5e, 0xf7, 0x7b, 0xe7,
+	0x3a, 0x1b, 0xd3, 0x1b, 0xce, 0x65, 0x63, 0x4c, 0xd9, 0x4d, 0x6a, 0x0b, 0xea, 0xda, 0x3f, 0xaf,
+	0xa4, 0xe6, 0x3b, 0xf7, 0x6f, 0x2c, 0xd3, 0x3b, 0x39, 0x84, 0x96, 0xc6, 0x6e, 0x88, 0xf2, 0x6b,
+	0x56, 0xe3, 0xac, 0xb3, 0xbe, 0x5e, 0x77, 0xde, 0x9c, 0xda, 0xd7, 0x0d, 0x76, 0xd8, 0xc5, 0x1e,
+	0x1f, 0x00, 0xa4, 0xc1, 0x7f, 0x92, 0x09, 0x3e, 0xab, 0x1d, 0x2c, 0x7f, 0x3f, 0x60, 0xea, 0x8b,
+	0x8c, 0x51, 0x63, 0x8d, 0x5f, 0xe1, 0x66, 0x45, 0xf0, 0xc7, 0xaa, 0xf7, 0xf9, 0x28, 0xbd, 0x6d,
+	0x17, 0x91, 0x8a, 0x8c, 0x8a, 0xac, 0x9f, 0x3c, 0x81, 0xf9, 0xfd, 0x30, 0x7c, 0x36, 0x1e, 0xc9,
+	0x1e, 0x13, 0x33, 0xde, 0x87, 0x77, 0x09, 0x76, 0x66, 0x14, 0xce, 0x1a, 0xab, 0xca, 0x26, 0x1d,
+	0xad, 0xaa, 0x8d, 0x17, 0xe9, 0xe5, 0xc2, 0x4b, 0xe2, 0xc1, 0x82, 0x72, 0x2e, 0x54, 0xc7, 0x6d,
+	0xb3, 0x1a, 0x3d, 0x2c, 0x9e, 0x6b, 0xc2, 0x70, 0xf7, 0x64, 0x6f, 0x37, 0x62, 0x59, 0xe7, 0x6d,
+	0x8b, 0x1c, 0x40, 0x63, 0x87, 0xf6, 0xc2, 0x3e, 0x15, 0xc1, 0x9c, 0xc5, 0xb4, 0xe3, 0x2a, 0x0a,
+	0x64, 0xcf, 0x1b, 0xa0, 0x69, 0xbf, 0x47, 0xde, 0x24, 0xa2, 0x5f, 0xdf, 0x78, 0x21, 0xc2, 0x44,
+	0x2f, 0xa5, 0xfd, 0x3e, 0x50, 0x01, 0xe0, 0xe9, 0x61, 0x59, 0xfb, 0x4a, 0x21, 0xad, 0x68, 0xaa,
+	0x55, 0xbc, 0x74, 0x80, 0x11, 0xb2, 0x4c, 0xd4, 0x92, 0xbc, 0x29, 0x77, 0xe0, 0x29, 0xb1, 0x4e,
+	0x7b, 0x6d, 0x3a, 0x83, 0xd9, 0xda, 0xba, 0xd9, 0x5a, 0x0c, 0xf3, 0x46, 0xf8, 0x50, 0xb9, 0x2c,
+	0x45, 0xc1, 0x51, 0xfb, 0x6a, 0x31, 0x51, 0xb4, 0x70, 0x83, 0xb5, 0xf0, 0xd6, 0xfa, 0x9b, 0x7a,
+	0x0b, 0x1b, 0x2f, 0xc4, 0x2f, 0x6d, 0xd9, 0x3f, 0xb2, 0x60, 0xa9, 0x28, 0x68, 0x49, 0x9c, 0xa2,
+	0xfa, 0xcd, 0xc0, 0xa8, 0xfd, 0xb1, 0x57, 0xf2, 0x88, 0xae, 0xbc, 0xc3, 0xba, 0xf2, 0xf6, 0xfa,
+	0x75, 0xa3, 0x2b, 0x3c, 0x64, 0xba, 0xf1, 0xc2, 0x0c, 0xa1, 0xbe, 0x24, 0x87, 0x38, 0x09, 0x5c,
+	0x62, 0x78, 0xd2, 0x52, 0xe6, 0xf5, 0xb3, 0x9e, 0x12, 0x65, 0x2f, 0x16, 0xd0, 0x4c, 0x2f, 0x85,
+	0x65, 0x0c, 0x91, 0xaf, 0x40, 0xfd, 0x3e, 0x4d, 0x64, 0x96, 0x92, 0xf2, 0x76, 0x33, 0x69, 0x4b,
+	0x76, 0x41, 0x92, 0x93, 0xa9, 0x38, 0xac, 0xb6, 0x0d, 0x4c, 0x7b, 0xe2, 0x16, 0xba, 0xeb, 0xf7,
+	0x5f, 0x92, 0x9f, 0x67, 0x95, 0xab, 0x34, 0xc9, 0x15, 0x2d, 0xb9, 0x45, 0xaf, 0xbc, 0x95, 0xc1,
+	0x8b, 0x6a, 0x0e, 0xc2, 0x3e, 0xd5, 0xfc, 0xb5, 0x00, 0xea, 0x5a, 0x76, 0xaf, 0xb2, 0x22, 0xf9,
+	0x4c, 0x65, 0xdb, 0x2e, 0x22, 0x89, 0xf9, 0xbf, 0xc9, 0xda, 0x71, 0xc8, 0x5a, 0xda, 0x0e, 0x4f,
+	0x00, 0x4e, 0x5b, 0xda, 0x78, 0xe1, 0x0d, 0x93, 0x97, 0xe4, 0x29, 0x7b, 0x09, 0xad, 0x67, 0x62,
+	0xa5, 0xee, 0x7b, 0x36, 0x69, 0xcb, 0x26, 0x79, 0x92, 0xe9, 0xd2, 0xf3, 0xa6, 0x98, 0x5b, 0xf7,
+	0x49, 0x00, 0xcc, 0x25, 0xda, 0xf1, 0xe8, 0x30, 0x0c, 0xd2, 0x0d, 0x27, 0xcd, 0x36, 0xb2, 0x17,
+	0x0d, 0x4c, 0xf8, 0xdd, 0x4f, 0xb5, 0xf3, 0x8e, 0xbe, 0xc4, 0x44, 0x6a, 0xd8, 0xd4, 0x84, 0x24,
+	0xdb, 0x2e, 0xe2, 0x50, 0xae, 0xc8, 0x16, 0x40, 0x1a, 0xb1, 0x56, 0xa7, 0x97, 0x5c, 0x30, 0xdc,
+	0xbe, 0x5c, 0x40, 0x11, 0x7d, 0x3b, 0x80, 0x5a, 0x1a, 0x02, 0x5d, 0x4d, 0x33, 0xb4, 0x8d, 0x80,
+	0xa9, 0xdd, 0xc9, 0x13, 0xc4, 0xaa, 0xb4, 0xd9, 0x54, 0x01, 0xa9, 0xe2, 0x54, 0xb1, 0x68, 0xa3,
+	0x0f, 0x8b, 0xbc, 0x83, 0xca, 0x27, 0x63, 0xf9, 0x33, 0x72, 0x24, 0x05, 0xc1, 0x41, 0xfb, 0x4a,
+	0x21, 0xad, 0x28, 0x8e, 0x81, 0xd2, 0xca, 0x73, 0x77, 0x70, 0x7f, 0x1a, 0xc2, 0x42, 0x2e, 0x30,
+	0xa4, 0xec, 0xda, 0xb4, 0x78, 0x9c, 0xbd, 0x36, 0x9d, 0x41, 0x34, 0xb9, 0xcc, 0x9a, 0x6c, 0x39,
+	0x80, 0x4d, 0xc6, 0xe7, 0x7e, 0xd2, 0x3b, 0xfd, 0xc0, 0x5a, 0x3f, 0x9a, 0x65, 0x7f, 0xe9, 0xf9,
+	0xf1, 0xff, 0x1b, 0x00, 0xd7, 0xb8, 0x8a, 0xb6, 0x04, 0x54, 0x00, 0x00,
 }
@@ -4625,6 +4625,22 @@ func (lc *LightningChannel) SettleHTLC(preimage [32]byte,
 	return nil
 }
 
+// IncomingHtlcExpiry returns the absolute CLTV expiry of the HTLC with the
+// passed index that was offered to us by the remote party. If the HTLC isn't
+// found within the remote party's update log, then ErrUnknownHtlcIndex is
+// returned.
+func (lc *LightningChannel) IncomingHtlcExpiry(htlcIndex uint64) (uint32, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	htlc := lc.remoteUpdateLog.lookupHtlc(htlcIndex)
+	if htlc == nil {
+		return 0, ErrUnknownHtlcIndex{lc.ShortChanID(), htlcIndex}
+	}
+
+	return htlc.Timeout, nil
+}
+
 // ReceiveHTLCSettle attempts to settle an existing outgoing HTLC indexed by an
 // index into the local log. If the specified index doesn't exist within the
 // log, and error is returned. Similarly if the preimage is invalid w.r.t to
@@ -6156,3 +6156,42 @@ func TestChannelRestoreCommitHeight(t *testing.T) {
 	aliceChannel.Stop()
 	bobChannel.Stop()
 }
+
+// TestIncomingHtlcExpiry tests that the expiry of an HTLC offered to us by the
+// remote party can be looked up by its index.
+func TestIncomingHtlcExpiry(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// Alice will offer an HTLC to Bob with a distinct expiry.
+	htlc, _ := createHTLC(0, lnwire.MilliSatoshi(1e7))
+	htlc.Expiry = 500
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	htlcIndex, err := bobChannel.ReceiveHTLC(htlc)
+	if err != nil {
+		t.Fatalf("bob unable to receive htlc: %v", err)
+	}
+
+	// Bob should be able to look up the expiry of the incoming HTLC.
+	expiry, err := bobChannel.IncomingHtlcExpiry(htlcIndex)
+	if err != nil {
+		t.Fatalf("unable to fetch htlc expiry: %v", err)
+	}
+	if expiry != htlc.Expiry {
+		t.Fatalf("expected expiry %v, got %v", htlc.Expiry, expiry)
+	}
+
+	// As the HTLC was offered by Bob's remote party, Alice shouldn't find
+	// it among her own incoming HTLCs.
+	_, err = aliceChannel.IncomingHtlcExpiry(htlcIndex)
+	if _, ok := err.(ErrUnknownHtlcIndex); !ok {
+		t.Fatalf("expected ErrUnknownHtlcIndex, got %v", err)
+	}
+}
@@ -169,6 +169,17 @@ type Config struct {
 		htlcAdd *lnwire.UpdateAddHTLC,
 		circuit *sphinx.Circuit) ([sha256.Size]byte, error)
 
+	// RecordPaymentAttempt is a function that persists the outcome of a
+	// single attempt to route a payment, such that the history of a
+	// payment can be inspected after the fact.
+	RecordPaymentAttempt func(paymentHash [32]byte,
+		attempt *channeldb.PaymentAttempt) error
+
+	// RecordPaymentFailure is a function that persists the time at which
+	// a payment finally failed, along with the reason it failed.
+	RecordPaymentFailure func(paymentHash [32]byte, failDate time.Time,
+		reason string) error
+
 	// ChannelPruneExpiry is the duration used to determine if a channel
 	// should be pruned or not. If the delta between now and when the
 	// channel was last updated is greater than ChannelPruneExpiry, then
@@ -1589,7 +1600,12 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte, *Route
 		return [32]byte{}, nil, err
 	}
 
-	return r.sendPayment(payment, paySession)
+	preImage, route, err := r.sendPayment(payment, paySession)
+	if err != nil {
+		r.recordPaymentFailure(payment.PaymentHash, err)
+	}
+
+	return preImage, route, err
 }
 
 // SendToRoute attempts to send a payment as described within the passed
@@ -1606,7 +1622,12 @@ func (r *ChannelRouter) SendToRoute(routes []*Route,
 		routes,
 	)
 
-	return r.sendPayment(payment, paySession)
+	preImage, route, err := r.sendPayment(payment, paySession)
+	if err != nil {
+		r.recordPaymentFailure(payment.PaymentHash, err)
+	}
+
+	return preImage, route, err
 }
 
 // sendPayment attempts to send a payment as described within the passed
@@ -1741,9 +1762,18 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		firstHop := lnwire.NewShortChanIDFromInt(
 			route.Hops[0].ChannelID,
 		)
+		attemptTime := time.Now()
 		preImage, sendError = r.cfg.SendToSwitch(
 			firstHop, htlcAdd, circuit,
 		)
+
+		// We'll record the outcome of this attempt before acting on
+		// it, so that the payment's history is kept regardless of
+		// whether we go on to retry it.
+		r.recordPaymentAttempt(
+			payment.PaymentHash, route, attemptTime, sendError,
+		)
+
 		if sendError != nil {
 			// An error occurred when attempting to send the
 			// payment, depending on the error type, we'll either
@@ -1991,6 +2021,51 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 	}
 }
 
+// recordPaymentAttempt persists the outcome of an attempt to send the payment
+// to the target payment hash over the passed route. As failing to do so
+// doesn't affect the payment itself, any error is only logged.
+func (r *ChannelRouter) recordPaymentAttempt(paymentHash [32]byte,
+	route *Route, attemptTime time.Time, sendError error) {
+
+	attempt := &channeldb.PaymentAttempt{
+		Timestamp: attemptTime,
+		Path:      make([][33]byte, len(route.Hops)),
+	}
+	for i, hop := range route.Hops {
+		attempt.Path[i] = hop.PubKeyBytes
+	}
+
+	if sendError != nil {
+		attempt.Error = sendError.Error()
+
+		fErr, ok := sendError.(*htlcswitch.ForwardingError)
+		if ok && fErr.FailureMessage != nil {
+			attempt.FailureCode = fErr.FailureMessage.Code()
+		}
+	}
+
+	err := r.cfg.RecordPaymentAttempt(paymentHash, attempt)
+	if err != nil {
+		log.Errorf("Unable to record attempt of payment %x: %v",
+			paymentHash, err)
+	}
+}
+
+// recordPaymentFailure persists the final failure of the payment to the target
+// payment hash. As failing to do so doesn't affect the payment itself, any
+// error is only logged.
+func (r *ChannelRouter) recordPaymentFailure(paymentHash [32]byte,
+	failure error) {
+
+	err := r.cfg.RecordPaymentFailure(
+		paymentHash, time.Now(), failure.Error(),
+	)
+	if err != nil {
+		log.Errorf("Unable to record failure of payment %x: %v",
+			paymentHash, err)
+	}
+}
+
 // pruneVertexFailure will attempt to prune a vertex from the current available
 // vertexes of the target payment session in response to an encountered routing
 // error.
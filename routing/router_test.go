@@ -93,6 +93,16 @@ func createTestCtxFromGraphInstance(startingHeight uint32, graphInstance *testGr
 
 			return [32]byte{}, nil
 		},
+		RecordPaymentAttempt: func(_ [32]byte,
+			_ *channeldb.PaymentAttempt) error {
+
+			return nil
+		},
+		RecordPaymentFailure: func(_ [32]byte, _ time.Time,
+			_ string) error {
+
+			return nil
+		},
 		ChannelPruneExpiry: time.Hour * 24,
 		GraphPruneInterval: time.Hour * 2,
 		QueryBandwidth: func(e *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
@@ -324,6 +334,16 @@ func TestSendPaymentRouteFailureFallback(t *testing.T) {
 		return preImage, nil
 	}
 
+	// We'll also capture the attempts recorded for the payment, so we can
+	// verify that both the failed and successful attempts are recorded.
+	var attempts []*channeldb.PaymentAttempt
+	ctx.router.cfg.RecordPaymentAttempt = func(_ [32]byte,
+		attempt *channeldb.PaymentAttempt) error {
+
+		attempts = append(attempts, attempt)
+		return nil
+	}
+
 	// Send off the payment request to the router, route through satoshi
 	// should've been selected as a fall back and succeeded correctly.
 	paymentPreImage, route, err := ctx.router.SendPayment(&payment)
@@ -352,6 +372,105 @@ func TestSendPaymentRouteFailureFallback(t *testing.T) {
 			getAliasFromPubKey(route.Hops[0].PubKeyBytes[:],
 				ctx.aliases))
 	}
+
+	// The failed attempt directly to luo ji should have been recorded,
+	// followed by the successful attempt through satoshi.
+	if len(attempts) != 2 {
+		t.Fatalf("expected 2 payment attempts, got %v", len(attempts))
+	}
+	if attempts[0].FailureCode != lnwire.CodeTemporaryChannelFailure {
+		t.Fatalf("expected first attempt to fail with %v, got %v",
+			lnwire.CodeTemporaryChannelFailure,
+			attempts[0].FailureCode)
+	}
+	if len(attempts[0].Path) != 1 {
+		t.Fatalf("expected first attempt path of length 1, got %v",
+			len(attempts[0].Path))
+	}
+	if attempts[1].FailureCode != 0 || attempts[1].Error != "" {
+		t.Fatalf("expected second attempt to succeed, got %v: %v",
+			attempts[1].FailureCode, attempts[1].Error)
+	}
+	if attempts[1].Path[0] != route.Hops[0].PubKeyBytes {
+		t.Fatalf("expected second attempt through satoshi")
+	}
+}
+
+// TestSendPaymentRecordFailure tests that the final failure of a payment is
+// recorded along with the reason it failed.
+func TestSendPaymentRecordFailure(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	var payHash [32]byte
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	// The destination will reject the payment hash as unknown, which
+	// should cause the payment to fail immediately.
+	sourceNode := ctx.router.selfNode
+	ctx.router.cfg.SendToSwitch = func(_ lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+
+		pub, err := sourceNode.PubKey()
+		if err != nil {
+			return [32]byte{}, err
+		}
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    pub,
+			FailureMessage: &lnwire.FailUnknownPaymentHash{},
+		}
+	}
+
+	var (
+		attempts   []*channeldb.PaymentAttempt
+		failReason string
+	)
+	ctx.router.cfg.RecordPaymentAttempt = func(_ [32]byte,
+		attempt *channeldb.PaymentAttempt) error {
+
+		attempts = append(attempts, attempt)
+		return nil
+	}
+	ctx.router.cfg.RecordPaymentFailure = func(hash [32]byte,
+		_ time.Time, reason string) error {
+
+		if hash != payHash {
+			t.Fatalf("failure recorded for wrong payment hash %x",
+				hash)
+		}
+		failReason = reason
+		return nil
+	}
+
+	_, _, err = ctx.router.SendPayment(&payment)
+	if err == nil {
+		t.Fatalf("expected payment to fail")
+	}
+
+	if len(attempts) != 1 {
+		t.Fatalf("expected 1 payment attempt, got %v", len(attempts))
+	}
+	if attempts[0].FailureCode != lnwire.CodeUnknownPaymentHash {
+		t.Fatalf("expected attempt to fail with %v, got %v",
+			lnwire.CodeUnknownPaymentHash, attempts[0].FailureCode)
+	}
+	if failReason != err.Error() {
+		t.Fatalf("expected failure reason %q, got %q", err.Error(),
+			failReason)
+	}
 }
 
 // TestChannelUpdateValidation tests that a failed payment with an associated
@@ -306,6 +306,14 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/DeletePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/DeletePaymentsBefore": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/DebugLevel": {{
 			Entity: "info",
 			Action: "write",
@@ -1961,12 +1969,16 @@ func (r *rpcServer) savePayment(route *routing.Route,
 		copy(paymentPath[i][:], hopPub[:])
 	}
 
+	// The payment was settled just now. If any attempts were recorded
+	// for it, then its creation date will become that of the first one.
+	now := time.Now()
 	payment := &channeldb.OutgoingPayment{
 		Invoice: channeldb.Invoice{
 			Terms: channeldb.ContractTerm{
 				Value: amount,
 			},
-			CreationDate: time.Now(),
+			CreationDate: now,
+			SettleDate:   now,
 		},
 		Path:           paymentPath,
 		Fee:            route.TotalFees,
@@ -3882,19 +3894,45 @@ func marshallTopologyChange(topChange *routing.TopologyChange) *lnrpc.GraphTopol
 	}
 }
 
-// ListPayments returns a list of all outgoing payments.
+// ListPayments returns a list of outgoing payments. If no pagination
+// parameters are specified, then all payments are returned.
 func (r *rpcServer) ListPayments(ctx context.Context,
-	_ *lnrpc.ListPaymentsRequest) (*lnrpc.ListPaymentsResponse, error) {
+	req *lnrpc.ListPaymentsRequest) (*lnrpc.ListPaymentsResponse, error) {
 
 	rpcsLog.Debugf("[ListPayments]")
 
-	payments, err := r.server.chanDB.FetchAllPayments()
-	if err != nil && err != channeldb.ErrNoPaymentsCreated {
+	// If the number of payments was not specified, then we'll default to
+	// returning all matching payments, as done before pagination was
+	// supported.
+	if req.NumMaxPayments == 0 {
+		req.NumMaxPayments = math.MaxUint64
+	}
+
+	q := channeldb.PaymentsQuery{
+		IndexOffset:    req.IndexOffset,
+		NumMaxPayments: req.NumMaxPayments,
+		Reversed:       req.Reversed,
+	}
+	if req.StartTime != 0 {
+		q.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime != 0 {
+		q.EndTime = time.Unix(req.EndTime, 0)
+	}
+	for _, status := range req.Statuses {
+		q.Statuses = append(q.Statuses, channeldb.PaymentStatus(status))
+	}
+
+	paymentsSlice, err := r.server.chanDB.QueryPayments(q)
+	if err != nil {
 		return nil, err
 	}
 
+	payments := paymentsSlice.Payments
 	paymentsResp := &lnrpc.ListPaymentsResponse{
-		Payments: make([]*lnrpc.Payment, len(payments)),
+		Payments:         make([]*lnrpc.Payment, len(payments)),
+		FirstIndexOffset: paymentsSlice.FirstIndexOffset,
+		LastIndexOffset:  paymentsSlice.LastIndexOffset,
 	}
 	for i, payment := range payments {
 		path := make([]string, len(payment.Path))
@@ -3934,6 +3972,69 @@ func (r *rpcServer) DeleteAllPayments(ctx context.Context,
 	return &lnrpc.DeleteAllPaymentsResponse{}, nil
 }
 
+// DeletePayment deletes the outgoing payment paying to the specified payment
+// hash from DB. The passed payment hash *must* be exactly 32 bytes, if not an
+// error is returned.
+func (r *rpcServer) DeletePayment(ctx context.Context,
+	req *lnrpc.DeletePaymentRequest) (*lnrpc.DeletePaymentResponse, error) {
+
+	var (
+		payHash [32]byte
+		rHash   []byte
+		err     error
+	)
+
+	// If the payment hash as a raw string was provided, then decode that
+	// and use that directly. Otherwise, we use the raw bytes provided.
+	if req.PaymentHashStr != "" {
+		rHash, err = hex.DecodeString(req.PaymentHashStr)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rHash = req.PaymentHash
+	}
+
+	// Ensure that the payment hash is *exactly* 32-bytes.
+	if len(rHash) != 32 {
+		return nil, fmt.Errorf("payment hash must be exactly "+
+			"32 bytes, is instead %v", len(rHash))
+	}
+	copy(payHash[:], rHash)
+
+	rpcsLog.Debugf("[DeletePayment] payment_hash=%x", payHash[:])
+
+	if err := r.server.chanDB.DeletePayment(payHash); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeletePaymentResponse{}, nil
+}
+
+// DeletePaymentsBefore deletes all outgoing payments created before the
+// specified time from DB.
+func (r *rpcServer) DeletePaymentsBefore(ctx context.Context,
+	req *lnrpc.DeletePaymentsBeforeRequest) (
+	*lnrpc.DeletePaymentsBeforeResponse, error) {
+
+	rpcsLog.Debugf("[DeletePaymentsBefore] created_before=%v",
+		req.CreatedBefore)
+
+	cutoff := time.Unix(req.CreatedBefore, 0)
+	numDeleted, err := r.server.chanDB.DeletePaymentsBefore(cutoff)
+	switch {
+	// If no payments have ever been created, then there's nothing to
+	// delete.
+	case err == channeldb.ErrNoPaymentsCreated:
+	case err != nil:
+		return nil, err
+	}
+
+	return &lnrpc.DeletePaymentsBeforeResponse{
+		NumDeleted: numDeleted,
+	}, nil
+}
+
 // DebugLevel allows a caller to programmatically set the logging verbosity of
 // lnd. The logging can be targeted according to a coarse daemon-wide logging
 // level, or in a granular fashion to specify the logging for a target
@@ -149,7 +149,7 @@ type server struct {
 
 	invoices *invoiceRegistry
 
-	witnessBeacon contractcourt.WitnessBeacon
+	witnessBeacon *preimageBeacon
 
 	breachArbiter *breachArbiter
 
@@ -294,11 +294,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		quit: make(chan struct{}),
 	}
 
-	s.witnessBeacon = &preimageBeacon{
-		invoices:    s.invoices,
-		wCache:      chanDB.NewWitnessCache(),
-		subscribers: make(map[uint64]*preimageSubscriber),
-	}
+	wCache := chanDB.NewWitnessCache()
+	wCache.MaxEntries = cfg.WitnessCacheMaxEntries
+
+	s.witnessBeacon = newPreimageBeacon(
+		s.invoices, wCache, s.cc.chainNotifier,
+	)
 
 	// If the debug HTLC flag is on, then we invoice a "master debug"
 	// invoice which all outgoing payments will be sent and all incoming
@@ -527,8 +528,10 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				firstHop, htlcAdd, errorDecryptor,
 			)
 		},
-		ChannelPruneExpiry: time.Duration(time.Hour * 24 * 14),
-		GraphPruneInterval: time.Duration(time.Hour),
+		RecordPaymentAttempt: chanDB.AddPaymentAttempt,
+		RecordPaymentFailure: chanDB.FailPayment,
+		ChannelPruneExpiry:   time.Duration(time.Hour * 24 * 14),
+		GraphPruneInterval:   time.Duration(time.Hour),
 		QueryBandwidth: func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
 			// If we aren't on either side of this edge, then we'll
 			// just thread through the capacity of the edge as we
@@ -979,6 +982,9 @@ func (s *server) Start() error {
 	if err := s.invoices.Start(); err != nil {
 		return err
 	}
+	if err := s.witnessBeacon.Start(); err != nil {
+		return err
+	}
 
 	// With all the relevant sub-systems started, we'll now attempt to
 	// establish persistent connections to our direct channel collaborators
@@ -1047,6 +1053,7 @@ func (s *server) Stop() error {
 	s.connMgr.Stop()
 	s.cc.feeEstimator.Stop()
 	s.invoices.Stop()
+	s.witnessBeacon.Stop()
 	s.fundingMgr.Stop()
 
 	// Disconnect from each active peers to ensure that
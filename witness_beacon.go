@@ -1,20 +1,87 @@
 package main
 
 import (
+	"crypto/sha256"
 	"sync"
+	"sync/atomic"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/queue"
 )
 
+// subscriberQueueWarnDepth is the number of preimages pending delivery to a
+// single subscriber at which we'll log a warning, repeated each time the
+// number of pending preimages reaches another multiple of it. As subscriber
+// queues are unbounded, this surfaces subscribers that have stopped consuming
+// their updates before they consume an unreasonable amount of memory.
+const subscriberQueueWarnDepth = 1000
+
 // preimageSubscriber reprints an active subscription to be notified once the
 // daemon discovers new preimages, either on chain or off-chain.
 type preimageSubscriber struct {
+	// queueDepth is the number of preimages queued for the subscriber
+	// that have yet to be received by it.
+	//
+	// NOTE: This MUST be used atomically.
+	queueDepth uint64
+
+	// id is the unique identifier of the subscriber.
+	id uint64
+
+	// updateChan is the channel over which preimages are delivered to the
+	// subscriber.
 	updateChan chan []byte
 
-	quit chan struct{}
+	// updateQueue is an unbounded queue of preimages that are yet to be
+	// received by the subscriber. Preimages are proxied from this queue to
+	// the updateChan in the order they were added, ensuring that a slow
+	// subscriber never misses a preimage, nor blocks the beacon. Preimages
+	// are never dropped, as a subscriber missing one could lose us the
+	// funds of an HTLC, so the queue's depth is monitored instead.
+	updateQueue *queue.ConcurrentQueue
+
+	// payHash, if non-nil, restricts the subscriber to only be notified of
+	// preimages matching this payment hash.
+	payHash *[32]byte
+
+	stopOnce sync.Once
+	quit     chan struct{}
+}
+
+// enqueue queues the preimage for delivery to the subscriber, warning if the
+// number of preimages pending delivery reaches another multiple of
+// subscriberQueueWarnDepth.
+func (s *preimageSubscriber) enqueue(pre []byte) {
+	s.updateQueue.ChanIn() <- pre
+
+	depth := atomic.AddUint64(&s.queueDepth, 1)
+	if depth%subscriberQueueWarnDepth == 0 {
+		srvrLog.Warnf("Witness beacon subscriber id=%v has %v "+
+			"preimages pending delivery", s.id, depth)
+	}
+}
+
+// stop signals the goroutine proxying preimages to the subscriber to exit,
+// and stops the subscriber's queue. It is safe to call stop multiple times.
+func (s *preimageSubscriber) stop() {
+	s.stopOnce.Do(func() {
+		close(s.quit)
+		s.updateQueue.Stop()
+	})
+}
+
+// invoiceTermSource is the source of invoice terms consulted by the
+// preimageBeacon in order to locate preimages for invoices we created
+// ourselves.
+type invoiceTermSource interface {
+	// LookupInvoiceTerms looks up the contract terms of the invoice
+	// identified by the payment hash. If the invoice isn't found,
+	// channeldb.ErrInvoiceNotFound is returned.
+	LookupInvoiceTerms(rHash chainhash.Hash) (channeldb.ContractTerm, error)
 }
 
 // preimageBeacon is an implementation of the contractcourt.WitnessBeacon
@@ -23,12 +90,103 @@ type preimageSubscriber struct {
 type preimageBeacon struct {
 	sync.RWMutex
 
-	invoices *invoiceRegistry
+	invoices invoiceTermSource
 
 	wCache *channeldb.WitnessCache
 
+	// notifier is used to receive new blocks, driving the pruning of
+	// expired preimages from the witness cache.
+	notifier chainntnfs.ChainNotifier
+
 	clientCounter uint64
 	subscribers   map[uint64]*preimageSubscriber
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newPreimageBeacon creates a new preimageBeacon backed by the passed invoice
+// source and witness cache.
+func newPreimageBeacon(invoices invoiceTermSource,
+	wCache *channeldb.WitnessCache,
+	notifier chainntnfs.ChainNotifier) *preimageBeacon {
+
+	return &preimageBeacon{
+		invoices:    invoices,
+		wCache:      wCache,
+		notifier:    notifier,
+		subscribers: make(map[uint64]*preimageSubscriber),
+		quit:        make(chan struct{}),
+	}
+}
+
+// Start launches the goroutine responsible for pruning expired preimages from
+// the witness cache as new blocks are connected.
+func (p *preimageBeacon) Start() error {
+	blockEpochs, err := p.notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+
+	p.wg.Add(1)
+	go p.witnessPruner(blockEpochs)
+
+	return nil
+}
+
+// Stop signals the beacon for a graceful shutdown, cancelling all active
+// subscriptions.
+func (p *preimageBeacon) Stop() {
+	close(p.quit)
+
+	p.Lock()
+	for clientID, client := range p.subscribers {
+		delete(p.subscribers, clientID)
+		client.stop()
+	}
+	p.Unlock()
+
+	p.wg.Wait()
+}
+
+// witnessPruner is the dedicated goroutine which prunes the witness cache each
+// time a new block is connected, removing all preimages that have expired as
+// of the new height.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *preimageBeacon) witnessPruner(
+	blockEpochs *chainntnfs.BlockEpochEvent) {
+
+	defer p.wg.Done()
+	defer blockEpochs.Cancel()
+
+	for {
+		select {
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			height := uint32(epoch.Height)
+			numPruned, err := p.wCache.PruneWitnesses(
+				channeldb.Sha256HashWitness, height,
+			)
+			if err != nil {
+				srvrLog.Errorf("Unable to prune witness "+
+					"cache at height=%v: %v", height, err)
+				continue
+			}
+
+			if numPruned > 0 {
+				srvrLog.Infof("Pruned %v preimages from "+
+					"witness cache at height=%v",
+					numPruned, height)
+			}
+
+		case <-p.quit:
+			return
+		}
+	}
 }
 
 // SubscribeUpdates returns a channel that will be sent upon *each* time a new
@@ -37,43 +195,167 @@ func (p *preimageBeacon) SubscribeUpdates() *contractcourt.WitnessSubscription {
 	p.Lock()
 	defer p.Unlock()
 
+	return p.subscribe(nil, nil, 0)
+}
+
+// SubscribeUpdatesForHash returns a channel that will be sent upon only when a
+// new preimage matching the target payment hash is discovered.
+//
+// NOTE: This isn't part of the contractcourt.WitnessBeacon interface.
+func (p *preimageBeacon) SubscribeUpdatesForHash(
+	payHash [32]byte) *contractcourt.WitnessSubscription {
+
+	p.Lock()
+	defer p.Unlock()
+
+	return p.subscribe(&payHash, nil, 0)
+}
+
+// SubscribeUpdatesSince returns a channel that will be sent upon *each* time a
+// new preimage is discovered. All preimages added to the witness cache after
+// the passed add index will be delivered before any new preimages.
+//
+// NOTE: This isn't part of the contractcourt.WitnessBeacon interface.
+func (p *preimageBeacon) SubscribeUpdatesSince(
+	addIndex uint64) (*contractcourt.WitnessSubscription, error) {
+
+	// We hold the lock while querying the witness cache, ensuring that no
+	// new preimages can be added between fetching the backlog and
+	// registering the subscriber.
+	p.Lock()
+	defer p.Unlock()
+
+	backlog, lastAddIndex, err := p.wCache.WitnessesAddedSince(
+		channeldb.Sha256HashWitness, addIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.subscribe(nil, backlog, lastAddIndex), nil
+}
+
+// SubscribeUpdatesForHashSince returns a channel that will be sent upon only
+// when a new preimage matching the target payment hash is discovered. If a
+// matching preimage was added to the witness cache after the passed add index,
+// then it will be delivered before any new preimages.
+func (p *preimageBeacon) SubscribeUpdatesForHashSince(payHash [32]byte,
+	addIndex uint64) (*contractcourt.WitnessSubscription, error) {
+
+	p.Lock()
+	defer p.Unlock()
+
+	added, lastAddIndex, err := p.wCache.WitnessesAddedSince(
+		channeldb.Sha256HashWitness, addIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the preimages matching the target payment hash are replayed to
+	// the subscriber.
+	var backlog [][]byte
+	for _, pre := range added {
+		if sha256.Sum256(pre) == payHash {
+			backlog = append(backlog, pre)
+		}
+	}
+
+	return p.subscribe(&payHash, backlog, lastAddIndex), nil
+}
+
+// subscribe registers a new preimage subscriber. If payHash is non-nil, then
+// the subscriber will only be sent preimages matching the payment hash. Any
+// backlog of preimages is queued for delivery ahead of new preimages.
+//
+// NOTE: This method MUST be called with the beacon's lock held.
+func (p *preimageBeacon) subscribe(payHash *[32]byte, backlog [][]byte,
+	addIndex uint64) *contractcourt.WitnessSubscription {
+
 	clientID := p.clientCounter
 	client := &preimageSubscriber{
-		updateChan: make(chan []byte, 10),
-		quit:       make(chan struct{}),
+		id:          clientID,
+		updateChan:  make(chan []byte),
+		updateQueue: queue.NewConcurrentQueue(20),
+		payHash:     payHash,
+		quit:        make(chan struct{}),
 	}
+	client.updateQueue.Start()
 
-	p.subscribers[p.clientCounter] = client
+	// As the queue is unbounded, we can add the entire backlog without
+	// blocking before any new preimages are sent.
+	for _, pre := range backlog {
+		client.enqueue(pre)
+	}
+
+	// With the backlog queued, we'll launch a goroutine to proxy all
+	// preimages appended to the end of the queue to the subscriber.
+	p.wg.Add(1)
+	go p.notifySubscriber(client)
+
+	p.subscribers[clientID] = client
 
 	p.clientCounter++
 
-	srvrLog.Debugf("Creating new witness beacon subscriber, id=%v",
-		p.clientCounter)
+	srvrLog.Debugf("Creating new witness beacon subscriber, id=%v, "+
+		"backlog=%v", clientID, len(backlog))
 
 	return &contractcourt.WitnessSubscription{
 		WitnessUpdates: client.updateChan,
+		AddIndex:       addIndex,
 		CancelSubscription: func() {
 			p.Lock()
-			defer p.Unlock()
-
 			delete(p.subscribers, clientID)
+			p.Unlock()
 
-			close(client.quit)
+			client.stop()
 		},
 	}
 }
 
+// notifySubscriber proxies all preimages queued for the subscriber to its
+// update channel, in the order they were queued, until either the
+// subscription is cancelled or the beacon is shutting down.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *preimageBeacon) notifySubscriber(client *preimageSubscriber) {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case item := <-client.updateQueue.ChanOut():
+			select {
+			case client.updateChan <- item.([]byte):
+				atomic.AddUint64(&client.queueDepth, ^uint64(0))
+
+			case <-client.quit:
+				return
+
+			case <-p.quit:
+				return
+			}
+
+		case <-client.quit:
+			return
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
 // LookupPreImage attempts to lookup a preimage in the global cache.  True is
 // returned for the second argument if the preimage is found.
+//
+// NOTE: The beacon's lock isn't held during the lookup, as both the invoice
+// registry and the witness cache are safe for concurrent use. This prevents
+// slow database lookups from blocking the addition of new preimages.
 func (p *preimageBeacon) LookupPreimage(payHash []byte) ([]byte, bool) {
-	p.RLock()
-	defer p.RUnlock()
-
 	// First, we'll check the invoice registry to see if we already know of
 	// the preimage as it's on that we created ourselves.
 	var invoiceKey chainhash.Hash
 	copy(invoiceKey[:], payHash)
-	invoice, _, err := p.invoices.LookupInvoice(invoiceKey)
+	terms, err := p.invoices.LookupInvoiceTerms(invoiceKey)
 	switch {
 	case err == channeldb.ErrInvoiceNotFound:
 		// If we get this error, then it simply means that this invoice
@@ -85,7 +367,7 @@ func (p *preimageBeacon) LookupPreimage(payHash []byte) ([]byte, bool) {
 	// If we've found the invoice, then we can return the preimage
 	// directly.
 	if err != channeldb.ErrInvoiceNotFound {
-		return invoice.Terms.PaymentPreimage[:], true
+		return terms.PaymentPreimage[:], true
 	}
 
 	// Otherwise, we'll perform a final check using the witness cache.
@@ -100,34 +382,118 @@ func (p *preimageBeacon) LookupPreimage(payHash []byte) ([]byte, bool) {
 	return preimage, true
 }
 
+// LookupPreimages attempts to lookup a batch of preimages in the global cache.
+// The returned slice is index-aligned with the passed payment hashes, and
+// contains a nil entry for each preimage that isn't found.
+func (p *preimageBeacon) LookupPreimages(payHashes ...[]byte) ([][]byte,
+	error) {
+
+	preimages := make([][]byte, len(payHashes))
+
+	// As with LookupPreimage, we'll first check the invoice registry for
+	// each payment hash, collecting those that aren't found so they can
+	// be looked up within the witness cache.
+	var (
+		witnessKeys    [][]byte
+		witnessIndexes []int
+	)
+	for i, payHash := range payHashes {
+		var invoiceKey chainhash.Hash
+		copy(invoiceKey[:], payHash)
+		terms, err := p.invoices.LookupInvoiceTerms(invoiceKey)
+		switch {
+		case err == channeldb.ErrInvoiceNotFound:
+			witnessKeys = append(witnessKeys, payHash)
+			witnessIndexes = append(witnessIndexes, i)
+
+		case err != nil:
+			return nil, err
+
+		default:
+			preimages[i] = terms.PaymentPreimage[:]
+		}
+	}
+
+	if len(witnessKeys) == 0 {
+		return preimages, nil
+	}
+
+	// With the remaining payment hashes collected, we'll look them all up
+	// within the witness cache at once.
+	witnesses, err := p.wCache.LookupWitnesses(
+		channeldb.Sha256HashWitness, witnessKeys,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for i, witness := range witnesses {
+		preimages[witnessIndexes[i]] = witness
+	}
+
+	return preimages, nil
+}
+
 // AddPreImage adds a newly discovered preimage to the global cache, and also
 // signals any subscribers of the newly discovered witness.
 func (p *preimageBeacon) AddPreimage(pre []byte) error {
+	return p.AddPreimages(pre)
+}
+
+// AddPreimages adds a batch of newly discovered preimages to the global cache
+// within a single database transaction, and also signals any subscribers of
+// the newly discovered witnesses.
+func (p *preimageBeacon) AddPreimages(preimages ...[]byte) error {
 	p.Lock()
 	defer p.Unlock()
 
-	srvrLog.Infof("Adding preimage=%x to witness cache", pre[:])
+	for _, pre := range preimages {
+		srvrLog.Infof("Adding preimage=%x to witness cache", pre[:])
+	}
 
-	// First, we'll add the witness to the decaying witness cache.
-	err := p.wCache.AddWitness(channeldb.Sha256HashWitness, pre)
+	// First, we'll add the witnesses to the decaying witness cache.
+	err := p.wCache.AddWitnesses(channeldb.Sha256HashWitness, preimages...)
 	if err != nil {
 		return err
 	}
 
-	// With the preimage added to our state, we'll now send a new
-	// notification to all subscribers.
-	for _, client := range p.subscribers {
-		go func(c *preimageSubscriber) {
-			select {
-			case c.updateChan <- pre:
-			case <-c.quit:
-				return
+	// With the preimages added to our state, we'll now queue a new
+	// notification for all subscribers, skipping any that are only
+	// interested in a different payment hash. As each subscriber's queue
+	// is unbounded, this never blocks on a slow subscriber, and as we
+	// hold the lock, each subscriber receives preimages in the order they
+	// were added.
+	for _, pre := range preimages {
+		payHash := sha256.Sum256(pre)
+		for _, client := range p.subscribers {
+			if client.payHash != nil && *client.payHash != payHash {
+				continue
 			}
-		}(client)
+
+			client.enqueue(pre)
+		}
 	}
 
 	return nil
 }
 
+// SetPreimageExpiry marks the preimage matching the target payment hash to be
+// pruned from the witness cache once the chain has advanced beyond
+// expiryHeight.
+func (p *preimageBeacon) SetPreimageExpiry(payHash [32]byte,
+	expiryHeight uint32) error {
+
+	err := p.wCache.SetWitnessExpiry(
+		channeldb.Sha256HashWitness, payHash[:], expiryHeight,
+	)
+
+	// If the preimage isn't within the witness cache, then it belongs to
+	// an invoice of our own, so there's nothing to expire.
+	if err == channeldb.ErrNoWitnesses {
+		return nil
+	}
+
+	return err
+}
+
 var _ contractcourt.WitnessBeacon = (*preimageBeacon)(nil)
 var _ lnwallet.PreimageCache = (*preimageBeacon)(nil)
@@ -0,0 +1,357 @@
+// +build !rpctest
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
+)
+
+// mockInvoiceTermSource is a stub invoiceTermSource backed by a map of
+// invoice terms keyed by payment hash.
+type mockInvoiceTermSource struct {
+	terms map[chainhash.Hash]channeldb.ContractTerm
+}
+
+func (m *mockInvoiceTermSource) LookupInvoiceTerms(
+	rHash chainhash.Hash) (channeldb.ContractTerm, error) {
+
+	terms, ok := m.terms[rHash]
+	if !ok {
+		return channeldb.ContractTerm{}, channeldb.ErrInvoiceNotFound
+	}
+
+	return terms, nil
+}
+
+// newTestPreimageBeacon creates a new preimageBeacon backed by a fresh
+// channeldb instance and the passed invoice terms. A callback which cleans up
+// the beacon and its database is also returned.
+func newTestPreimageBeacon(t *testing.T,
+	invoices *mockInvoiceTermSource) (*preimageBeacon, func()) {
+
+	t.Helper()
+
+	cdb, cleanUpDB, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to create test db: %v", err)
+	}
+
+	beacon := newPreimageBeacon(invoices, cdb.NewWitnessCache(), nil)
+	cleanUp := func() {
+		beacon.Stop()
+		cleanUpDB()
+	}
+
+	return beacon, cleanUp
+}
+
+// makeTestPreimages returns n unique preimages.
+func makeTestPreimages(n int) [][]byte {
+	preimages := make([][]byte, n)
+	for i := range preimages {
+		var preimage [32]byte
+		binary.BigEndian.PutUint64(preimage[:], uint64(i+1))
+		preimages[i] = preimage[:]
+	}
+
+	return preimages
+}
+
+// assertPreimagesReceived asserts that the passed preimages are delivered
+// over the subscription, in order.
+func assertPreimagesReceived(t *testing.T,
+	sub *contractcourt.WitnessSubscription, preimages [][]byte) {
+
+	t.Helper()
+
+	for i, expected := range preimages {
+		select {
+		case preimage := <-sub.WitnessUpdates:
+			if !bytes.Equal(preimage, expected) {
+				t.Fatalf("preimage %v mismatch: expected %x, "+
+					"got %x", i, expected, preimage)
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("preimage %v not received", i)
+		}
+	}
+}
+
+// assertNoPreimageReceived asserts that no preimage is delivered over the
+// subscription.
+func assertNoPreimageReceived(t *testing.T,
+	sub *contractcourt.WitnessSubscription) {
+
+	t.Helper()
+
+	select {
+	case preimage := <-sub.WitnessUpdates:
+		t.Fatalf("unexpected preimage received: %x", preimage)
+
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// TestPreimageBeaconLookupPreimage tests that preimages are located within
+// both the invoice term source and the witness cache, both individually and
+// as a batch, and that unknown preimages aren't found.
+func TestPreimageBeaconLookupPreimage(t *testing.T) {
+	t.Parallel()
+
+	preimages := makeTestPreimages(3)
+	invoicePreimage, witnessPreimage, unknownPreimage :=
+		preimages[0], preimages[1], preimages[2]
+
+	// We'll create an invoice for the first preimage, which should be
+	// located through the invoice term source.
+	var invoiceTerms channeldb.ContractTerm
+	copy(invoiceTerms.PaymentPreimage[:], invoicePreimage)
+	invoices := &mockInvoiceTermSource{
+		terms: map[chainhash.Hash]channeldb.ContractTerm{
+			sha256.Sum256(invoicePreimage): invoiceTerms,
+		},
+	}
+
+	beacon, cleanUp := newTestPreimageBeacon(t, invoices)
+	defer cleanUp()
+
+	// The second preimage will only be known to the witness cache.
+	if err := beacon.AddPreimage(witnessPreimage); err != nil {
+		t.Fatalf("unable to add preimage: %v", err)
+	}
+
+	assertLookup := func(preimage []byte, expectFound bool) {
+		t.Helper()
+
+		payHash := sha256.Sum256(preimage)
+		dbPreimage, ok := beacon.LookupPreimage(payHash[:])
+		switch {
+		case ok != expectFound:
+			t.Fatalf("expected found=%v for preimage %x, got %v",
+				expectFound, preimage, ok)
+
+		case ok && !bytes.Equal(dbPreimage, preimage):
+			t.Fatalf("preimage mismatch: expected %x, got %x",
+				preimage, dbPreimage)
+		}
+	}
+
+	assertLookup(invoicePreimage, true)
+	assertLookup(witnessPreimage, true)
+	assertLookup(unknownPreimage, false)
+
+	// Looking up all three preimages as a batch should yield the same
+	// results, with a nil entry for the unknown preimage.
+	payHashes := make([][]byte, len(preimages))
+	for i, preimage := range preimages {
+		payHash := sha256.Sum256(preimage)
+		payHashes[i] = payHash[:]
+	}
+	dbPreimages, err := beacon.LookupPreimages(payHashes...)
+	if err != nil {
+		t.Fatalf("unable to look up preimages: %v", err)
+	}
+
+	expected := [][]byte{invoicePreimage, witnessPreimage, nil}
+	if !reflect.DeepEqual(dbPreimages, expected) {
+		t.Fatalf("preimages don't match: expected %x, got %x",
+			expected, dbPreimages)
+	}
+}
+
+// TestPreimageBeaconSlowSubscriber tests that a subscriber which doesn't
+// consume its updates doesn't miss any preimages, nor prevent other
+// subscribers from receiving them.
+func TestPreimageBeaconSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	beacon, cleanUp := newTestPreimageBeacon(t, &mockInvoiceTermSource{})
+	defer cleanUp()
+
+	slowSub := beacon.SubscribeUpdates()
+	defer slowSub.CancelSubscription()
+
+	fastSub := beacon.SubscribeUpdates()
+	defer fastSub.CancelSubscription()
+
+	// We'll add many more preimages than can be buffered within the
+	// subscribers' update channels, only consuming those sent to the fast
+	// subscriber.
+	const numPreimages = 500
+	preimages := makeTestPreimages(numPreimages)
+	for i, preimage := range preimages {
+		if err := beacon.AddPreimage(preimage); err != nil {
+			t.Fatalf("unable to add preimage: %v", err)
+		}
+
+		assertPreimagesReceived(t, fastSub, preimages[i:i+1])
+	}
+
+	// All of the preimages should be counted as pending delivery to the
+	// slow subscriber.
+	var maxDepth uint64
+	beacon.RLock()
+	for _, client := range beacon.subscribers {
+		depth := atomic.LoadUint64(&client.queueDepth)
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+	beacon.RUnlock()
+	if maxDepth != numPreimages {
+		t.Fatalf("expected queue depth of %v, got %v", numPreimages,
+			maxDepth)
+	}
+
+	// The slow subscriber should now be able to receive every preimage,
+	// in the order they were added.
+	assertPreimagesReceived(t, slowSub, preimages)
+}
+
+// TestPreimageBeaconHashSubscriber tests that a subscriber for a particular
+// payment hash only receives the matching preimage, even when it's preceded
+// by many unrelated preimages.
+func TestPreimageBeaconHashSubscriber(t *testing.T) {
+	t.Parallel()
+
+	beacon, cleanUp := newTestPreimageBeacon(t, &mockInvoiceTermSource{})
+	defer cleanUp()
+
+	preimages := makeTestPreimages(300)
+	target := preimages[len(preimages)-1]
+
+	sub := beacon.SubscribeUpdatesForHash(sha256.Sum256(target))
+	defer sub.CancelSubscription()
+
+	if err := beacon.AddPreimages(preimages...); err != nil {
+		t.Fatalf("unable to add preimages: %v", err)
+	}
+
+	assertPreimagesReceived(t, sub, [][]byte{target})
+	assertNoPreimageReceived(t, sub)
+}
+
+// TestPreimageBeaconCancelSubscription tests that no preimages are delivered
+// once a subscription has been cancelled.
+func TestPreimageBeaconCancelSubscription(t *testing.T) {
+	t.Parallel()
+
+	beacon, cleanUp := newTestPreimageBeacon(t, &mockInvoiceTermSource{})
+	defer cleanUp()
+
+	sub := beacon.SubscribeUpdates()
+	sub.CancelSubscription()
+
+	// Cancelling the subscription a second time should be a no-op.
+	sub.CancelSubscription()
+
+	if err := beacon.AddPreimages(makeTestPreimages(10)...); err != nil {
+		t.Fatalf("unable to add preimages: %v", err)
+	}
+
+	assertNoPreimageReceived(t, sub)
+}
+
+// TestPreimageBeaconSubscribeSince tests that a subscriber created from an add
+// index checkpoint first receives all preimages added after the checkpoint, in
+// order, before any live preimages.
+func TestPreimageBeaconSubscribeSince(t *testing.T) {
+	t.Parallel()
+
+	beacon, cleanUp := newTestPreimageBeacon(t, &mockInvoiceTermSource{})
+	defer cleanUp()
+
+	preimages := makeTestPreimages(10)
+
+	// We'll add the first batch of preimages, then record a checkpoint
+	// from a subscription created before the second batch is added.
+	if err := beacon.AddPreimages(preimages[:3]...); err != nil {
+		t.Fatalf("unable to add preimages: %v", err)
+	}
+	checkpointSub, err := beacon.SubscribeUpdatesSince(0)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	checkpointSub.CancelSubscription()
+	if checkpointSub.AddIndex != 3 {
+		t.Fatalf("expected add index of 3, got %v",
+			checkpointSub.AddIndex)
+	}
+
+	if err := beacon.AddPreimages(preimages[3:6]...); err != nil {
+		t.Fatalf("unable to add preimages: %v", err)
+	}
+
+	// Subscribing from the checkpoint should replay only the second
+	// batch, and report the latest add index.
+	sub, err := beacon.SubscribeUpdatesSince(checkpointSub.AddIndex)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer sub.CancelSubscription()
+	if sub.AddIndex != 6 {
+		t.Fatalf("expected add index of 6, got %v", sub.AddIndex)
+	}
+
+	// Any preimages added afterwards should be delivered after the
+	// backlog, in the order they were added.
+	for _, preimage := range preimages[6:] {
+		if err := beacon.AddPreimage(preimage); err != nil {
+			t.Fatalf("unable to add preimage: %v", err)
+		}
+	}
+
+	assertPreimagesReceived(t, sub, preimages[3:])
+	assertNoPreimageReceived(t, sub)
+}
+
+// TestPreimageBeaconSubscribeForHashSince tests that a subscriber for a
+// particular payment hash created from an add index checkpoint has the
+// matching preimage replayed if it was added after the checkpoint.
+func TestPreimageBeaconSubscribeForHashSince(t *testing.T) {
+	t.Parallel()
+
+	beacon, cleanUp := newTestPreimageBeacon(t, &mockInvoiceTermSource{})
+	defer cleanUp()
+
+	preimages := makeTestPreimages(5)
+	target := preimages[3]
+	payHash := sha256.Sum256(target)
+
+	if err := beacon.AddPreimages(preimages...); err != nil {
+		t.Fatalf("unable to add preimages: %v", err)
+	}
+
+	// If the checkpoint is after the matching preimage was added, then
+	// it shouldn't be replayed.
+	sub, err := beacon.SubscribeUpdatesForHashSince(payHash, 4)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	if sub.AddIndex != 5 {
+		t.Fatalf("expected add index of 5, got %v", sub.AddIndex)
+	}
+	assertNoPreimageReceived(t, sub)
+	sub.CancelSubscription()
+
+	// Otherwise, only the matching preimage should be replayed.
+	sub, err = beacon.SubscribeUpdatesForHashSince(payHash, 1)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer sub.CancelSubscription()
+
+	assertPreimagesReceived(t, sub, [][]byte{target})
+	assertNoPreimageReceived(t, sub)
+}